@@ -0,0 +1,181 @@
+// Package anim plays sprite sheet animations. A sheet is a grid of equally
+// sized frames; an animation is a sequence of those frames, each shown for
+// a number of ticks, that either loops or plays once and holds its last
+// frame. It has no dependency on ebiten, the caller draws the frame.
+package anim
+
+import "image"
+
+// The animation names the game's sheets provide.
+const (
+	Idle  = "idle"
+	Run   = "run"
+	Jump  = "jump"
+	Fall  = "fall"
+	Shoot = "shoot"
+	Hurt  = "hurt"
+	Die   = "die"
+)
+
+type Frame struct {
+	// Index is the frame's position in the sheet, counted left to right
+	// and then top to bottom.
+	Index int
+	Ticks int
+}
+
+type Animation struct {
+	Frames []Frame
+	Loop   bool
+}
+
+// Sheet describes the layout of a sprite sheet image.
+type Sheet struct {
+	FrameWidth  int
+	FrameHeight int
+	Columns     int
+	Animations  map[string]*Animation
+}
+
+// FrameRect returns the area of frame index in the sheet image.
+func (s *Sheet) FrameRect(index int) image.Rectangle {
+	x := index % s.Columns * s.FrameWidth
+	y := index / s.Columns * s.FrameHeight
+	return image.Rect(x, y, x+s.FrameWidth, y+s.FrameHeight)
+}
+
+// Player plays one animation of a sheet at a time.
+type Player struct {
+	Sheet *Sheet
+
+	name    string
+	anim    *Animation
+	frame   int
+	elapsed int
+}
+
+// Play switches to the named animation, from its start. Playing the
+// animation already playing carries on with it; unknown names are
+// ignored.
+func (p *Player) Play(name string) {
+	if name == p.name {
+		return
+	}
+	p.Restart(name)
+}
+
+// Restart plays the named animation from its start.
+func (p *Player) Restart(name string) {
+	a, ok := p.Sheet.Animations[name]
+	if !ok {
+		return
+	}
+	p.name, p.anim = name, a
+	p.frame, p.elapsed = 0, 0
+}
+
+// Step advances the animation by one tick.
+func (p *Player) Step() {
+	if p.anim == nil || p.Done() {
+		return
+	}
+	p.elapsed++
+	if p.elapsed < p.anim.Frames[p.frame].Ticks {
+		return
+	}
+	p.elapsed = 0
+	switch {
+	case p.frame+1 < len(p.anim.Frames):
+		p.frame++
+	case p.anim.Loop:
+		p.frame = 0
+	default:
+		// hold the last frame
+		p.elapsed = p.anim.Frames[p.frame].Ticks
+	}
+}
+
+// Name returns the animation playing.
+func (p *Player) Name() string {
+	return p.name
+}
+
+// Frame returns the sheet index of the frame to draw.
+func (p *Player) Frame() int {
+	if p.anim == nil {
+		return 0
+	}
+	return p.anim.Frames[p.frame].Index
+}
+
+// Done reports whether an animation that plays once has reached its end.
+// Looping animations are never done.
+func (p *Player) Done() bool {
+	if p.anim == nil || p.anim.Loop {
+		return false
+	}
+	return p.frame == len(p.anim.Frames)-1 && p.elapsed >= p.anim.Frames[p.frame].Ticks
+}
+
+// State is the physics state an animation is picked from.
+type State struct {
+	OnGround bool
+	VX, VY   int
+	Hurt     bool
+	Dead     bool
+}
+
+// Choose returns the animation for a state.
+func Choose(s State) string {
+	switch {
+	case s.Dead:
+		return Die
+	case s.Hurt:
+		return Hurt
+	case !s.OnGround && s.VY < 0:
+		return Jump
+	case !s.OnGround:
+		return Fall
+	case s.VX != 0:
+		return Run
+	}
+	return Idle
+}
+
+// Machine plays the animation for the state it is given every tick. An
+// animation started with Trigger, like shooting, plays to its end first,
+// unless the state calls for hurt or die.
+type Machine struct {
+	Player
+	pending   string
+	triggered bool
+}
+
+func NewMachine(s *Sheet) *Machine {
+	return &Machine{Player: Player{Sheet: s}}
+}
+
+// Trigger plays the named animation once, from the next Update on, over
+// whatever the state calls for.
+func (m *Machine) Trigger(name string) {
+	m.pending = name
+}
+
+// Update advances the animation by one tick and then picks the one to
+// show for s. An animation picked anew starts on its first frame.
+func (m *Machine) Update(s State) {
+	m.Step()
+	name := Choose(s)
+	switch {
+	case name == Hurt || name == Die:
+		m.pending, m.triggered = "", false
+	case m.pending != "":
+		m.Restart(m.pending)
+		m.pending, m.triggered = "", true
+		return
+	case m.triggered && !m.Done():
+		return
+	}
+	m.triggered = false
+	m.Play(name)
+}
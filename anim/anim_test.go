@@ -0,0 +1,183 @@
+package anim
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testSheet() *Sheet {
+	return &Sheet{
+		FrameWidth:  10,
+		FrameHeight: 20,
+		Columns:     4,
+		Animations: map[string]*Animation{
+			Idle:  {Frames: []Frame{{0, 2}, {1, 3}}, Loop: true},
+			Run:   {Frames: []Frame{{2, 1}, {3, 1}, {4, 1}}, Loop: true},
+			Jump:  {Frames: []Frame{{5, 1}}, Loop: true},
+			Fall:  {Frames: []Frame{{6, 1}}, Loop: true},
+			Shoot: {Frames: []Frame{{7, 2}, {8, 1}}},
+			Hurt:  {Frames: []Frame{{9, 1}}, Loop: true},
+			Die:   {Frames: []Frame{{10, 1}, {11, 2}}},
+		},
+	}
+}
+
+// frames returns the frame shown before each of n steps.
+func frames(p *Player, n int) []int {
+	var f []int
+	for i := 0; i < n; i++ {
+		f = append(f, p.Frame())
+		p.Step()
+	}
+	return f
+}
+
+func TestFrameRect(t *testing.T) {
+	s := testSheet()
+	for _, c := range []struct {
+		index      int
+		x, y, w, h int
+	}{
+		{0, 0, 0, 10, 20},
+		{3, 30, 0, 10, 20},
+		{4, 0, 20, 10, 20},
+		{9, 10, 40, 10, 20},
+	} {
+		r := s.FrameRect(c.index)
+		if r.Min.X != c.x || r.Min.Y != c.y || r.Dx() != c.w || r.Dy() != c.h {
+			t.Errorf("frame %d: %v", c.index, r)
+		}
+	}
+}
+
+func TestFrameTiming(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		want []int
+		done bool
+	}{
+		// each frame shows for its ticks, looping back to the start
+		{Idle, []int{0, 0, 1, 1, 1, 0, 0, 1}, false},
+		{Run, []int{2, 3, 4, 2, 3, 4, 2}, false},
+		// playing once holds the last frame
+		{Shoot, []int{7, 7, 8, 8, 8, 8}, true},
+		{Die, []int{10, 11, 11, 11, 11}, true},
+	} {
+		p := &Player{Sheet: testSheet()}
+		p.Play(c.name)
+		if got := frames(p, len(c.want)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: frames %v, want %v", c.name, got, c.want)
+		}
+		if p.Done() != c.done {
+			t.Errorf("%s: Done() = %v, want %v", c.name, p.Done(), c.done)
+		}
+	}
+}
+
+func TestDone(t *testing.T) {
+	p := &Player{Sheet: testSheet()}
+	p.Play(Die)
+	// 10 for a tick, 11 for two
+	for i := 0; i < 3; i++ {
+		if p.Done() {
+			t.Fatalf("done after %d ticks", i)
+		}
+		p.Step()
+	}
+	if !p.Done() {
+		t.Error("not done at the end")
+	}
+}
+
+func TestPlay(t *testing.T) {
+	p := &Player{Sheet: testSheet()}
+	p.Play(Run)
+	p.Step()
+	p.Play(Run)
+	if p.Frame() != 3 {
+		t.Errorf("playing the same animation again started over at frame %d", p.Frame())
+	}
+	p.Restart(Run)
+	if p.Frame() != 2 {
+		t.Errorf("restart: frame %d, want 2", p.Frame())
+	}
+	p.Play("dance")
+	if p.Name() != Run {
+		t.Errorf("unknown animation replaced %q with %q", Run, p.Name())
+	}
+}
+
+func TestChoose(t *testing.T) {
+	for _, c := range []struct {
+		state State
+		want  string
+	}{
+		{State{OnGround: true}, Idle},
+		{State{OnGround: true, VX: 3}, Run},
+		{State{VY: -4}, Jump},
+		{State{VY: 0}, Fall},
+		{State{VY: 5, VX: 3}, Fall},
+		{State{OnGround: true, VX: 3, Hurt: true}, Hurt},
+		{State{VY: -4, Hurt: true, Dead: true}, Die},
+		{State{OnGround: true, Dead: true}, Die},
+	} {
+		if got := Choose(c.state); got != c.want {
+			t.Errorf("%+v: %s, want %s", c.state, got, c.want)
+		}
+	}
+}
+
+func TestMachineShoot(t *testing.T) {
+	m := NewMachine(testSheet())
+	running := State{OnGround: true, VX: 2}
+	m.Update(running)
+	if m.Name() != Run {
+		t.Fatalf("playing %s, want run", m.Name())
+	}
+
+	// shooting plays over running to its end, then running carries on
+	m.Trigger(Shoot)
+	var names []string
+	for i := 0; i < 5; i++ {
+		m.Update(running)
+		names = append(names, m.Name())
+	}
+	if want := []string{Shoot, Shoot, Shoot, Run, Run}; !reflect.DeepEqual(names, want) {
+		t.Errorf("animations %v, want %v", names, want)
+	}
+	if m.Frame() != 3 {
+		t.Errorf("run did not start over after shooting, frame %d", m.Frame())
+	}
+
+	// and over jumping
+	m.Trigger(Shoot)
+	m.Update(State{VY: -5})
+	if m.Name() != Shoot {
+		t.Errorf("playing %s in the air, want shoot", m.Name())
+	}
+}
+
+func TestMachineHurtAndDie(t *testing.T) {
+	m := NewMachine(testSheet())
+	m.Trigger(Shoot)
+	m.Update(State{OnGround: true})
+
+	// getting hurt cuts the shot short
+	m.Update(State{OnGround: true, Hurt: true})
+	if m.Name() != Hurt {
+		t.Errorf("playing %s when hurt, want hurt", m.Name())
+	}
+
+	// dying comes before everything, even a shot triggered on the same
+	// tick, and holds its last frame
+	m.Trigger(Shoot)
+	for i := 0; i < 10; i++ {
+		m.Update(State{OnGround: true, Hurt: true, Dead: true})
+		if m.Name() != Die {
+			t.Fatalf("tick %d: playing %s, want die", i, m.Name())
+		}
+	}
+	if !m.Done() || m.Frame() != 11 {
+		t.Errorf("done %v on frame %d, want the last frame held", m.Done(), m.Frame())
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"image"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	raudio "github.com/hajimehoshi/ebiten/v2/examples/resources/audio"
+	"github.com/hajimehoshi/ebiten/v2/examples/resources/fonts"
+	"github.com/hajimehoshi/ebiten/v2/examples/resources/images/flappy"
+
+	"github.com/mariuseis/go-inn/assets"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// The IDs of the assets the code asks for by name. Levels name their
+// decorations by image ID too.
+const (
+	imagePlayer      = "player"
+	imageEnemy       = "enemy"
+	imagePlayerSheet = "player_sheet"
+	imageEnemySheet  = "enemy_sheet"
+	imageBullet      = "bullet"
+	imageCoin        = "coin"
+	imageInn         = "inn"
+	imageTiles       = "tiles"
+	fontArcade       = "arcade"
+)
+
+// builtinAssets are the files named in the manifest that come with ebiten
+// rather than from the images directory.
+var builtinAssets = map[string][]byte{
+	"ebiten/flappy/tiles.png":       flappy.Tiles_png,
+	"ebiten/audio/jump.ogg":         raudio.Jump_ogg,
+	"ebiten/audio/jab.wav":          raudio.Jab_wav,
+	"ebiten/audio/ragtime.ogg":      raudio.Ragtime_ogg,
+	"ebiten/fonts/PressStart2P.ttf": fonts.PressStart2P_ttf,
+}
+
+var (
+	catalog     *assets.Catalog
+	assetImages = map[string]*ebiten.Image{}
+)
+
+// assetImage returns the image with the ID, or nil when there is none.
+func assetImage(id string) *ebiten.Image {
+	return assetImages[id]
+}
+
+// loadAssets loads everything in the manifest and makes the images and
+// font faces from it. The coin is drawn rather than loaded. Assets that
+// load but do not fit the game, like a hitbox that differs from the
+// collider, are reported along with the broken ones.
+func loadAssets(l *assets.Loader) error {
+	c, err := l.Load()
+	if c == nil {
+		return err
+	}
+	var problems []string
+	var aerr *assets.Error
+	if errors.As(err, &aerr) {
+		problems = aerr.Problems
+	} else if err != nil {
+		return err
+	}
+
+	catalog = c
+	for _, info := range c.Manifest.Images {
+		if img := c.Image(info.ID); img != nil {
+			assetImages[info.ID] = ebiten.NewImageFromImage(img)
+		}
+	}
+	assetImages[imageCoin] = ebiten.NewImageFromImage(drawCoin(world.CoinSize))
+	loadSheets()
+
+	problems = append(problems, checkAssets(c)...)
+	problems = append(problems, loadFonts(c)...)
+	if len(problems) > 0 {
+		return &assets.Error{Problems: problems}
+	}
+	return nil
+}
+
+// colliders are the sizes the world gives the entities drawn with the
+// images, which their hitboxes have to match.
+var colliders = map[string]image.Point{
+	imagePlayer: {world.PlayerWidth, world.PlayerHeight},
+	imageEnemy:  {world.PlayerWidth, world.PlayerHeight},
+	imageBullet: {world.ProjectileSize, world.ProjectileSize},
+}
+
+// checkAssets reports the assets the code needs that the manifest does not
+// have, actor sheets missing animations, and hitboxes that do not match the
+// colliders.
+func checkAssets(c *assets.Catalog) []string {
+	var problems []string
+	for _, id := range []string{imagePlayer, imageEnemy, imagePlayerSheet, imageEnemySheet, imageBullet, imageInn, imageTiles} {
+		if _, ok := c.ImageInfo(id); !ok {
+			problems = append(problems, fmt.Sprintf("image %q is missing from the manifest", id))
+		}
+	}
+	for _, id := range []string{imagePlayerSheet, imageEnemySheet} {
+		info, ok := c.ImageInfo(id)
+		if !ok {
+			continue
+		}
+		for _, name := range actorAnimations {
+			if _, ok := info.Animations[name]; !ok {
+				problems = append(problems, fmt.Sprintf("image %q has no %q animation", id, name))
+			}
+		}
+	}
+	for _, id := range []string{sfxJump, sfxHit, musicRagtime} {
+		if _, _, ok := c.Sound(id); !ok {
+			problems = append(problems, fmt.Sprintf("sound %q is missing", id))
+		}
+	}
+	hasFont := false
+	for _, f := range c.Manifest.Fonts {
+		hasFont = hasFont || f.ID == fontArcade
+	}
+	if !hasFont {
+		problems = append(problems, fmt.Sprintf("font %q is missing from the manifest", fontArcade))
+	}
+	for _, id := range []string{imagePlayer, imageEnemy, imageBullet} {
+		size := colliders[id]
+		if c.Image(id) == nil {
+			continue
+		}
+		if h := c.Hitbox(id); h.Size() != size {
+			problems = append(problems, fmt.Sprintf("image %q: hitbox is %dx%d, the collider is %dx%d",
+				id, h.Dx(), h.Dy(), size.X, size.Y))
+		}
+	}
+	return problems
+}
+
+// loadFonts makes the font faces. A font that did not load has been
+// reported already.
+func loadFonts(c *assets.Catalog) []string {
+	tt := c.Font(fontArcade)
+	if tt == nil {
+		return nil
+	}
+	var problems []string
+	face := func(size float64) font.Face {
+		const dpi = 72
+		f, err := opentype.NewFace(tt, &opentype.FaceOptions{
+			Size:    size,
+			DPI:     dpi,
+			Hinting: font.HintingFull,
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("font %q: %v", fontArcade, err))
+		}
+		return f
+	}
+	titleArcadeFont = face(titleFontSize)
+	arcadeFont = face(fontSize)
+	smallArcadeFont = face(smallFontSize)
+	return problems
+}
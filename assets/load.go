@@ -0,0 +1,194 @@
+package assets
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/png" // the images are PNGs
+	"io/fs"
+
+	"golang.org/x/image/font/opentype"
+)
+
+// Loader reads the manifest and the files it names. Files are looked up in
+// Files first and in FS after, so assets that come from elsewhere, like
+// the ones bundled with ebiten, can be named in the manifest too.
+type Loader struct {
+	FS    fs.FS
+	Files map[string][]byte
+}
+
+// ReadFile returns the contents of an asset file.
+func (l *Loader) ReadFile(name string) ([]byte, error) {
+	if b, ok := l.Files[name]; ok {
+		return b, nil
+	}
+	if l.FS == nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return fs.ReadFile(l.FS, name)
+}
+
+// Manifest reads and validates the manifest from FS.
+func (l *Loader) Manifest() (*Manifest, error) {
+	f, err := l.FS.Open(ManifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("assets: %w", err)
+	}
+	defer f.Close()
+	return ReadManifest(f)
+}
+
+// Load reads the manifest and every asset in it. When some assets are
+// broken the others are still loaded, and the error lists all broken ones.
+func (l *Loader) Load() (*Catalog, error) {
+	m, err := l.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Catalog{
+		Manifest: m,
+		images:   map[string]image.Image{},
+		sounds:   map[string][]byte{},
+		fonts:    map[string]*opentype.Font{},
+	}
+	var problems []string
+	for _, info := range m.Images {
+		img, err := l.LoadImage(info)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		c.images[info.ID] = img
+	}
+	for _, s := range m.Sounds {
+		b, err := l.ReadFile(s.File)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("sound %q: %v", s.ID, err))
+			continue
+		}
+		c.sounds[s.ID] = b
+	}
+	for _, f := range m.Fonts {
+		b, err := l.ReadFile(f.File)
+		if err == nil {
+			c.fonts[f.ID], err = opentype.Parse(b)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("font %q: %v", f.ID, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return c, &Error{Problems: problems}
+	}
+	return c, nil
+}
+
+// LoadImage reads and decodes one image and checks it against its frame
+// size and hitbox.
+func (l *Loader) LoadImage(info Image) (image.Image, error) {
+	b, err := l.ReadFile(info.File)
+	if err != nil {
+		return nil, fmt.Errorf("image %q: %v", info.ID, err)
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("image %q: %s: %v", info.ID, info.File, err)
+	}
+
+	size := img.Bounds().Size()
+	frame := image.Rect(0, 0, size.X, size.Y)
+	if info.FrameWidth > 0 {
+		if size.X%info.FrameWidth != 0 || size.Y%info.FrameHeight != 0 {
+			return nil, fmt.Errorf("image %q: %dx%d is not a grid of %dx%d frames",
+				info.ID, size.X, size.Y, info.FrameWidth, info.FrameHeight)
+		}
+		frame = image.Rect(0, 0, info.FrameWidth, info.FrameHeight)
+	}
+	frames := (size.X / frame.Dx()) * (size.Y / frame.Dy())
+	for _, name := range sortedNames(info.Animations) {
+		for _, f := range info.Animations[name].Frames {
+			if f >= frames {
+				return nil, fmt.Errorf("image %q: animation %q: frame %d is past the %d frames of the sheet",
+					info.ID, name, f, frames)
+			}
+		}
+	}
+	if h := info.Hitbox; h != nil && !h.Rectangle().In(frame) {
+		return nil, fmt.Errorf("image %q: hitbox %v is outside the %dx%d frame",
+			info.ID, h.Rectangle(), frame.Dx(), frame.Dy())
+	}
+	return img, nil
+}
+
+// Catalog holds the loaded assets by ID.
+type Catalog struct {
+	Manifest *Manifest
+	images   map[string]image.Image
+	sounds   map[string][]byte
+	fonts    map[string]*opentype.Font
+}
+
+// Image returns the decoded image, or nil when there is none with the ID.
+func (c *Catalog) Image(id string) image.Image {
+	return c.images[id]
+}
+
+// SetImage replaces a loaded image, after it was reloaded.
+func (c *Catalog) SetImage(id string, img image.Image) {
+	c.images[id] = img
+}
+
+// ImageInfo returns the manifest entry of an image.
+func (c *Catalog) ImageInfo(id string) (Image, bool) {
+	for _, img := range c.Manifest.Images {
+		if img.ID == id {
+			return img, true
+		}
+	}
+	return Image{}, false
+}
+
+// Frame returns the bounds of frame i of an image. Frames are numbered
+// left to right, top to bottom.
+func (c *Catalog) Frame(id string, i int) image.Rectangle {
+	img := c.images[id]
+	if img == nil {
+		return image.Rectangle{}
+	}
+	info, _ := c.ImageInfo(id)
+	if info.FrameWidth == 0 {
+		return img.Bounds()
+	}
+	columns := img.Bounds().Dx() / info.FrameWidth
+	x, y := i%columns*info.FrameWidth, i/columns*info.FrameHeight
+	return image.Rect(x, y, x+info.FrameWidth, y+info.FrameHeight).Add(img.Bounds().Min)
+}
+
+// Hitbox returns the hitbox of an image, or its whole frame when the
+// manifest gives none.
+func (c *Catalog) Hitbox(id string) image.Rectangle {
+	info, _ := c.ImageInfo(id)
+	if info.Hitbox != nil {
+		return info.Hitbox.Rectangle()
+	}
+	return c.Frame(id, 0).Sub(c.Frame(id, 0).Min)
+}
+
+// Sound returns the encoded sound and its manifest entry.
+func (c *Catalog) Sound(id string) ([]byte, Sound, bool) {
+	for _, s := range c.Manifest.Sounds {
+		if s.ID == id {
+			b, ok := c.sounds[id]
+			return b, s, ok
+		}
+	}
+	return nil, Sound{}, false
+}
+
+// Font returns the parsed font, or nil when there is none with the ID.
+func (c *Catalog) Font(id string) *opentype.Font {
+	return c.fonts[id]
+}
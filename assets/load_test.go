@@ -0,0 +1,140 @@
+package assets
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// pngFile returns a blank PNG of the given size.
+func pngFile(t *testing.T, w, h int) *fstest.MapFile {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatal(err)
+	}
+	return &fstest.MapFile{Data: buf.Bytes()}
+}
+
+func TestLoadImage(t *testing.T) {
+	l := &Loader{FS: fstest.MapFS{
+		"single.png": pngFile(t, 10, 20),
+		"sheet.png":  pngFile(t, 32, 16),
+		"broken.png": {Data: []byte("not a png")},
+	}}
+	for _, c := range []struct {
+		name string
+		info Image
+		err  string
+	}{
+		{"single", Image{ID: "a", File: "single.png", Hitbox: &Rect{X: 2, Y: 2, Width: 8, Height: 18}}, ""},
+		{"sheet", Image{ID: "a", File: "sheet.png", FrameWidth: 8, FrameHeight: 8,
+			Animations: map[string]Animation{"run": {Frames: []int{0, 7}, Ticks: []int{1}}}}, ""},
+		{"missing", Image{ID: "a", File: "missing.png"}, `image "a": open missing.png`},
+		{"broken", Image{ID: "a", File: "broken.png"}, `image "a": broken.png: image: unknown format`},
+		{"grid", Image{ID: "a", File: "sheet.png", FrameWidth: 10, FrameHeight: 8},
+			`image "a": 32x16 is not a grid of 10x8 frames`},
+		{"hitbox outside the image", Image{ID: "a", File: "single.png", Hitbox: &Rect{X: 5, Width: 10, Height: 10}},
+			`image "a": hitbox (5,0)-(15,10) is outside the 10x20 frame`},
+		{"hitbox outside the frame", Image{ID: "a", File: "sheet.png", FrameWidth: 8, FrameHeight: 8, Hitbox: &Rect{Width: 9, Height: 8}},
+			`image "a": hitbox (0,0)-(9,8) is outside the 8x8 frame`},
+		{"animation past the sheet", Image{ID: "a", File: "sheet.png", FrameWidth: 8, FrameHeight: 8,
+			Animations: map[string]Animation{"run": {Frames: []int{0, 8}, Ticks: []int{1}}}},
+			`image "a": animation "run": frame 8 is past the 8 frames of the sheet`},
+	} {
+		img, err := l.LoadImage(c.info)
+		if c.err == "" {
+			if err != nil || img == nil {
+				t.Errorf("%s: %v", c.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasPrefix(err.Error(), c.err) {
+			t.Errorf("%s: error %v, want %q", c.name, err, c.err)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	l := &Loader{
+		FS: fstest.MapFS{
+			ManifestFile: {Data: []byte(`{"version": 1,
+				"images": [
+					{"id": "good", "file": "good.png"},
+					{"id": "sheet", "file": "sheet.png", "frameWidth": 8, "frameHeight": 8},
+					{"id": "grid", "file": "good.png", "frameWidth": 3, "frameHeight": 3},
+					{"id": "missing", "file": "missing.png"},
+					{"id": "builtin", "file": "builtin/tiles.png"}
+				],
+				"sounds": [
+					{"id": "jump", "file": "jump.wav"},
+					{"id": "gone", "file": "gone.ogg"}
+				],
+				"fonts": [
+					{"id": "go", "file": "go.ttf"},
+					{"id": "bad", "file": "bad.ttf"}
+				]}`)},
+			"good.png":  pngFile(t, 4, 4),
+			"sheet.png": pngFile(t, 16, 8),
+			"jump.wav":  {Data: []byte("RIFF")},
+			"go.ttf":    {Data: goregular.TTF},
+			"bad.ttf":   {Data: []byte("not a font")},
+		},
+		Files: map[string][]byte{"builtin/tiles.png": pngFile(t, 2, 2).Data},
+	}
+	c, err := l.Load()
+	if c == nil {
+		t.Fatalf("no catalog: %v", err)
+	}
+
+	got := problems(t, err)
+	want := []string{`image "grid"`, `image "missing"`, `sound "gone"`, `font "bad"`}
+	if len(got) != len(want) {
+		t.Fatalf("problems %q, want one for each of %q", got, want)
+	}
+	for i, p := range got {
+		if !strings.HasPrefix(p, want[i]) {
+			t.Errorf("problem %d: %q, want it about %s", i, p, want[i])
+		}
+	}
+
+	// the assets that are fine are loaded regardless
+	for _, id := range []string{"good", "sheet", "builtin"} {
+		if c.Image(id) == nil {
+			t.Errorf("image %q not loaded", id)
+		}
+	}
+	if c.Image("missing") != nil || c.Image("grid") != nil {
+		t.Error("broken images loaded")
+	}
+	if b, s, ok := c.Sound("jump"); !ok || string(b) != "RIFF" || s.File != "jump.wav" {
+		t.Errorf("sound jump: %q, %+v, %v", b, s, ok)
+	}
+	if _, _, ok := c.Sound("gone"); ok {
+		t.Error("missing sound loaded")
+	}
+	if c.Font("go") == nil || c.Font("bad") != nil {
+		t.Errorf("fonts: go %v, bad %v", c.Font("go"), c.Font("bad"))
+	}
+
+	if r := c.Frame("sheet", 1); r != image.Rect(8, 0, 16, 8) {
+		t.Errorf("frame 1 of sheet: %v", r)
+	}
+	if r := c.Frame("good", 3); r != image.Rect(0, 0, 4, 4) {
+		t.Errorf("frame of a single image: %v", r)
+	}
+	if r := c.Hitbox("sheet"); r != image.Rect(0, 0, 8, 8) {
+		t.Errorf("hitbox without one given: %v", r)
+	}
+}
+
+func TestLoadWithoutManifest(t *testing.T) {
+	c, err := (&Loader{FS: fstest.MapFS{}}).Load()
+	if c != nil || err == nil {
+		t.Errorf("catalog %v, error %v, want none and an error", c, err)
+	}
+}
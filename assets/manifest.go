@@ -0,0 +1,202 @@
+// Package assets describes the game's images, sounds and fonts in a
+// manifest and loads them, reporting every broken asset at once.
+package assets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Version is the manifest format this build reads.
+const Version = 1
+
+// ManifestFile is the name of the manifest in the asset directory.
+const ManifestFile = "manifest.json"
+
+// Rect is a rectangle inside an image.
+type Rect struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Rectangle returns r as an image.Rectangle.
+func (r Rect) Rectangle() image.Rectangle {
+	return image.Rect(r.X, r.Y, r.X+r.Width, r.Y+r.Height)
+}
+
+// Image is a PNG. An image without a frame size is a single frame; one
+// with a frame size is a sprite sheet, a grid of frames.
+type Image struct {
+	ID          string `json:"id"`
+	File        string `json:"file"`
+	FrameWidth  int    `json:"frameWidth,omitempty"`
+	FrameHeight int    `json:"frameHeight,omitempty"`
+	// Hitbox is the part of a frame that collides, relative to the
+	// frame's top left corner.
+	Hitbox *Rect `json:"hitbox,omitempty"`
+	// Animations are the sheet's animations by name.
+	Animations map[string]Animation `json:"animations,omitempty"`
+}
+
+// Animation is a sequence of frames of a sprite sheet, numbered left to
+// right and then top to bottom. Ticks is how long the frames show, either
+// one value for all of them or one per frame. An animation that does not
+// loop holds its last frame.
+type Animation struct {
+	Frames []int `json:"frames"`
+	Ticks  []int `json:"ticks"`
+	Loop   bool  `json:"loop,omitempty"`
+}
+
+// FrameTicks returns how long frame i of the animation shows.
+func (a Animation) FrameTicks(i int) int {
+	if len(a.Ticks) == 1 {
+		return a.Ticks[0]
+	}
+	return a.Ticks[i]
+}
+
+// Sound is a WAV or Ogg Vorbis file. Music is streamed and has to be Ogg
+// Vorbis.
+type Sound struct {
+	ID    string `json:"id"`
+	File  string `json:"file"`
+	Music bool   `json:"music,omitempty"`
+}
+
+// Font is a TrueType or OpenType file.
+type Font struct {
+	ID   string `json:"id"`
+	File string `json:"file"`
+}
+
+// Manifest lists the assets by ID.
+type Manifest struct {
+	Version int     `json:"version"`
+	Images  []Image `json:"images"`
+	Sounds  []Sound `json:"sounds,omitempty"`
+	Fonts   []Font  `json:"fonts,omitempty"`
+}
+
+// Error lists every problem found in the manifest or its assets, not just
+// the first.
+type Error struct {
+	Problems []string
+}
+
+func (e *Error) Error() string {
+	return "assets: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks the manifest for missing and duplicate IDs and for
+// values the loader cannot work with.
+func (m *Manifest) Validate() error {
+	var problems []string
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if m.Version != Version {
+		add("unsupported version %d (this build reads version %d)", m.Version, Version)
+	}
+	seen := map[string]bool{}
+	check := func(kind string, i int, id, file string) {
+		switch {
+		case id == "":
+			add("%s[%d]: id is empty", kind, i)
+		case seen[kind+"/"+id]:
+			add("%s[%d]: duplicate id %q", kind, i, id)
+		}
+		seen[kind+"/"+id] = true
+		if file == "" {
+			add("%s[%d]: file is empty", kind, i)
+		}
+	}
+	for i, img := range m.Images {
+		check("images", i, img.ID, img.File)
+		if img.FrameWidth < 0 || img.FrameHeight < 0 || (img.FrameWidth == 0) != (img.FrameHeight == 0) {
+			add("images[%d]: frame size %dx%d", i, img.FrameWidth, img.FrameHeight)
+		}
+		if h := img.Hitbox; h != nil && (h.Width <= 0 || h.Height <= 0) {
+			add("images[%d]: hitbox size %dx%d", i, h.Width, h.Height)
+		}
+		if len(img.Animations) > 0 && img.FrameWidth == 0 {
+			add("images[%d]: animations need a frame size", i)
+		}
+		for _, name := range sortedNames(img.Animations) {
+			a := img.Animations[name]
+			if len(a.Frames) == 0 {
+				add("images[%d]: animation %q has no frames", i, name)
+			}
+			for _, f := range a.Frames {
+				if f < 0 {
+					add("images[%d]: animation %q: frame %d", i, name, f)
+				}
+			}
+			if len(a.Ticks) != 1 && len(a.Ticks) != len(a.Frames) {
+				add("images[%d]: animation %q has %d ticks for %d frames", i, name, len(a.Ticks), len(a.Frames))
+			}
+			for _, t := range a.Ticks {
+				if t <= 0 {
+					add("images[%d]: animation %q: %d ticks", i, name, t)
+				}
+			}
+		}
+	}
+	for i, s := range m.Sounds {
+		check("sounds", i, s.ID, s.File)
+		switch ext := strings.ToLower(path.Ext(s.File)); {
+		case ext == ".ogg":
+		case ext == ".wav" && !s.Music:
+		case s.Music:
+			add("sounds[%d]: music has to be Ogg Vorbis, got %q", i, s.File)
+		default:
+			add("sounds[%d]: unsupported format %q", i, s.File)
+		}
+	}
+	for i, f := range m.Fonts {
+		check("fonts", i, f.ID, f.File)
+	}
+
+	if len(problems) > 0 {
+		return &Error{Problems: problems}
+	}
+	return nil
+}
+
+// sortedNames returns the names of the animations in order, so that
+// problems are reported the same way every time.
+func sortedNames(animations map[string]Animation) []string {
+	var names []string
+	for name := range animations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ReadManifest decodes and validates a manifest.
+func ReadManifest(r io.Reader) (*Manifest, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("assets: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	m := &Manifest{}
+	if err := dec.Decode(m); err != nil {
+		return nil, fmt.Errorf("assets: %s: %w", ManifestFile, err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
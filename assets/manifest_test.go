@@ -0,0 +1,144 @@
+package assets
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// validManifest returns a manifest that passes Validate.
+func validManifest() *Manifest {
+	return &Manifest{
+		Version: Version,
+		Images: []Image{
+			{ID: "player", File: "player.png", Hitbox: &Rect{Width: 10, Height: 20}},
+			{ID: "sheet", File: "sheet.png", FrameWidth: 8, FrameHeight: 8, Animations: map[string]Animation{
+				"idle":  {Frames: []int{0, 1}, Ticks: []int{10}, Loop: true},
+				"shoot": {Frames: []int{2, 3}, Ticks: []int{4, 6}},
+			}},
+		},
+		Sounds: []Sound{
+			{ID: "jump", File: "jump.wav"},
+			{ID: "music", File: "music.ogg", Music: true},
+		},
+		// the same ID can name assets of different kinds
+		Fonts: []Font{{ID: "player", File: "font.ttf"}},
+	}
+}
+
+func problems(t *testing.T, err error) []string {
+	t.Helper()
+	var aerr *Error
+	if !errors.As(err, &aerr) {
+		t.Fatalf("error %v, want an *Error", err)
+	}
+	return aerr.Problems
+}
+
+func TestValidate(t *testing.T) {
+	if err := validManifest().Validate(); err != nil {
+		t.Fatalf("valid manifest: %v", err)
+	}
+
+	for _, c := range []struct {
+		name    string
+		change  func(m *Manifest)
+		problem string
+	}{
+		{"version", func(m *Manifest) { m.Version = 2 }, "unsupported version 2 (this build reads version 1)"},
+		{"duplicate image", func(m *Manifest) { m.Images = append(m.Images, Image{ID: "player", File: "b.png"}) },
+			`images[2]: duplicate id "player"`},
+		{"duplicate sound", func(m *Manifest) { m.Sounds[1].ID = "jump" }, `sounds[1]: duplicate id "jump"`},
+		{"empty id", func(m *Manifest) { m.Fonts[0].ID = "" }, "fonts[0]: id is empty"},
+		{"empty file", func(m *Manifest) { m.Images[0].File = "" }, "images[0]: file is empty"},
+		{"frame width only", func(m *Manifest) { m.Images[0].FrameWidth = 10 }, "images[0]: frame size 10x0"},
+		{"negative frame", func(m *Manifest) { m.Images[1].FrameHeight = -8 }, "images[1]: frame size 8x-8"},
+		{"hitbox", func(m *Manifest) { m.Images[0].Hitbox.Height = 0 }, "images[0]: hitbox size 10x0"},
+		{"animations without frames", func(m *Manifest) { m.Images[1].FrameWidth, m.Images[1].FrameHeight = 0, 0 },
+			"images[1]: animations need a frame size"},
+		{"animation without frames", func(m *Manifest) { m.Images[1].Animations["idle"] = Animation{Ticks: []int{1}} },
+			`images[1]: animation "idle" has no frames`},
+		{"negative frame index", func(m *Manifest) { m.Images[1].Animations["idle"].Frames[1] = -1 },
+			`images[1]: animation "idle": frame -1`},
+		{"ticks per frame", func(m *Manifest) {
+			m.Images[1].Animations["idle"] = Animation{Frames: []int{0, 1, 2}, Ticks: []int{1, 2}}
+		}, `images[1]: animation "idle" has 2 ticks for 3 frames`},
+		{"zero ticks", func(m *Manifest) { m.Images[1].Animations["shoot"].Ticks[0] = 0 },
+			`images[1]: animation "shoot": 0 ticks`},
+		{"music format", func(m *Manifest) { m.Sounds[1].File = "music.wav" },
+			`sounds[1]: music has to be Ogg Vorbis, got "music.wav"`},
+		{"sound format", func(m *Manifest) { m.Sounds[0].File = "jump.mp3" }, `sounds[0]: unsupported format "jump.mp3"`},
+	} {
+		m := validManifest()
+		c.change(m)
+		if got := problems(t, m.Validate()); !reflect.DeepEqual(got, []string{c.problem}) {
+			t.Errorf("%s: problems %q, want %q", c.name, got, c.problem)
+		}
+	}
+}
+
+func TestValidateReportsAll(t *testing.T) {
+	m := validManifest()
+	m.Images[0].File = ""
+	m.Images[1].Animations["idle"] = Animation{Ticks: []int{1}}
+	m.Images[1].Animations["shoot"].Ticks[1] = -2
+	m.Sounds[0].ID = ""
+	m.Fonts = append(m.Fonts, Font{ID: "player", File: "other.ttf"})
+
+	want := []string{
+		"images[0]: file is empty",
+		`images[1]: animation "idle" has no frames`,
+		`images[1]: animation "shoot": -2 ticks`,
+		"sounds[0]: id is empty",
+		`fonts[1]: duplicate id "player"`,
+	}
+	err := m.Validate()
+	if got := problems(t, err); !reflect.DeepEqual(got, want) {
+		t.Errorf("problems\n%q\nwant\n%q", got, want)
+	}
+	if msg := err.Error(); msg != "assets: "+strings.Join(want, "; ") {
+		t.Errorf("message %q", msg)
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	m, err := ReadManifest(strings.NewReader(`{"version": 1, "images": [
+		{"id": "sheet", "file": "sheet.png", "frameWidth": 8, "frameHeight": 8,
+		 "animations": {"run": {"frames": [0, 1], "ticks": [6], "loop": true}}}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Animation{Frames: []int{0, 1}, Ticks: []int{6}, Loop: true}
+	if got := m.Images[0].Animations["run"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("animation %+v, want %+v", got, want)
+	}
+
+	for _, c := range []struct {
+		name, data string
+	}{
+		{"unknown field", `{"version": 1, "images": [{"id": "a", "file": "a.png", "frame": 8}]}`},
+		{"syntax", `{"version": 1, "images": [`},
+		{"invalid", `{"version": 1, "images": [{"id": "a"}]}`},
+	} {
+		if _, err := ReadManifest(strings.NewReader(c.data)); err == nil {
+			t.Errorf("%s: no error", c.name)
+		}
+	}
+}
+
+func TestFrameTicks(t *testing.T) {
+	a := Animation{Frames: []int{0, 1, 2}, Ticks: []int{5}}
+	for i := range a.Frames {
+		if a.FrameTicks(i) != 5 {
+			t.Errorf("frame %d: %d ticks, want 5", i, a.FrameTicks(i))
+		}
+	}
+	a.Ticks = []int{1, 2, 3}
+	for i := range a.Frames {
+		if a.FrameTicks(i) != i+1 {
+			t.Errorf("frame %d: %d ticks, want %d", i, a.FrameTicks(i), i+1)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Package collision provides axis-aligned rectangles, overlap queries and
+// movement that resolves against solid bodies one axis at a time.
+package collision
+
+// Rect is an axis-aligned box with its top left corner at X, Y.
+type Rect struct {
+	X int
+	Y int
+	W int
+	H int
+}
+
+func (r Rect) Right() int {
+	return r.X + r.W
+}
+
+func (r Rect) Bottom() int {
+	return r.Y + r.H
+}
+
+func (r Rect) Translate(dx, dy int) Rect {
+	r.X += dx
+	r.Y += dy
+	return r
+}
+
+// Overlaps reports whether the rectangles share any area. Rectangles that
+// only touch along an edge do not overlap.
+func (r Rect) Overlaps(o Rect) bool {
+	return r.X < o.Right() && o.X < r.Right() && r.Y < o.Bottom() && o.Y < r.Bottom()
+}
+
+// Vec is an integer 2D vector.
+type Vec struct {
+	X int
+	Y int
+}
+
+// Penetration returns the shortest translation that moves a out of b, and
+// false when they do not overlap.
+func Penetration(a, b Rect) (Vec, bool) {
+	if !a.Overlaps(b) {
+		return Vec{}, false
+	}
+	left := a.Right() - b.X
+	right := b.Right() - a.X
+	up := a.Bottom() - b.Y
+	down := b.Bottom() - a.Y
+
+	best := Vec{X: -left}
+	depth := left
+	if right < depth {
+		best, depth = Vec{X: right}, right
+	}
+	if up < depth {
+		best, depth = Vec{Y: -up}, up
+	}
+	if down < depth {
+		best = Vec{Y: down}
+	}
+	return best, true
+}
+
+// Body is a rectangle owned by something identified by ID.
+type Body struct {
+	ID   int
+	Rect Rect
+}
+
+// Contact describes a collision with another body.
+type Contact struct {
+	// Normal points away from the other body's surface that was hit, so
+	// {0, -1} means the mover landed on top of it.
+	Normal Vec
+	// Depth is how far the mover had gone into the other body before it
+	// was pushed back out.
+	Depth int
+	Other int
+}
+
+// Overlapping returns the bodies that overlap r.
+func Overlapping(r Rect, bodies []Body) []Body {
+	var hits []Body
+	for _, b := range bodies {
+		if r.Overlaps(b.Rect) {
+			hits = append(hits, b)
+		}
+	}
+	return hits
+}
+
+// Move moves r by dx, dy, first horizontally and then vertically. After
+// each axis it is pushed back out of every solid it ended up in, so it can
+// hit walls, land on floors and bump into ceilings. It returns where r
+// ended up and the contacts made on the way.
+func Move(r Rect, dx, dy int, solids []Body) (Rect, []Contact) {
+	var contacts []Contact
+
+	r.X += dx
+	for _, s := range solids {
+		if !r.Overlaps(s.Rect) {
+			continue
+		}
+		c := Contact{Other: s.ID}
+		switch {
+		case dx > 0:
+			c.Depth = r.Right() - s.Rect.X
+			c.Normal = Vec{X: -1}
+			r.X -= c.Depth
+		case dx < 0:
+			c.Depth = s.Rect.Right() - r.X
+			c.Normal = Vec{X: 1}
+			r.X += c.Depth
+		default:
+			continue
+		}
+		contacts = append(contacts, c)
+	}
+
+	r.Y += dy
+	for _, s := range solids {
+		if !r.Overlaps(s.Rect) {
+			continue
+		}
+		c := Contact{Other: s.ID}
+		switch {
+		case dy > 0:
+			c.Depth = r.Bottom() - s.Rect.Y
+			c.Normal = Vec{Y: -1}
+			r.Y -= c.Depth
+		case dy < 0:
+			c.Depth = s.Rect.Bottom() - r.Y
+			c.Normal = Vec{Y: 1}
+			r.Y += c.Depth
+		default:
+			// Started out inside the solid without moving vertically,
+			// take the shortest way out.
+			v, _ := Penetration(r, s.Rect)
+			c.Normal = Vec{X: sign(v.X), Y: sign(v.Y)}
+			c.Depth = abs(v.X + v.Y)
+			r = r.Translate(v.X, v.Y)
+		}
+		contacts = append(contacts, c)
+	}
+
+	return r, contacts
+}
+
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
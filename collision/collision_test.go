@@ -0,0 +1,129 @@
+package collision
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOverlaps(t *testing.T) {
+	a := Rect{X: 0, Y: 0, W: 10, H: 10}
+	for _, c := range []struct {
+		b    Rect
+		want bool
+	}{
+		{Rect{X: 5, Y: 5, W: 10, H: 10}, true},
+		{Rect{X: 2, Y: 2, W: 2, H: 2}, true},
+		{Rect{X: -5, Y: -5, W: 30, H: 30}, true},
+		// touching edges and corners
+		{Rect{X: 10, Y: 0, W: 10, H: 10}, false},
+		{Rect{X: 0, Y: -10, W: 10, H: 10}, false},
+		{Rect{X: 10, Y: 10, W: 5, H: 5}, false},
+		{Rect{X: 20, Y: 20, W: 5, H: 5}, false},
+	} {
+		if got := a.Overlaps(c.b); got != c.want {
+			t.Errorf("%+v overlaps %+v: %v, want %v", a, c.b, got, c.want)
+		}
+		if got := c.b.Overlaps(a); got != c.want {
+			t.Errorf("%+v overlaps %+v: %v, want %v", c.b, a, got, c.want)
+		}
+	}
+}
+
+func TestPenetration(t *testing.T) {
+	b := Rect{X: 0, Y: 0, W: 100, H: 100}
+	for _, c := range []struct {
+		name string
+		a    Rect
+		want Vec
+		ok   bool
+	}{
+		{"apart", Rect{X: 200, Y: 0, W: 10, H: 10}, Vec{}, false},
+		{"touching", Rect{X: 100, Y: 0, W: 10, H: 10}, Vec{}, false},
+		{"left edge", Rect{X: -8, Y: 40, W: 10, H: 10}, Vec{X: -2}, true},
+		{"right edge", Rect{X: 97, Y: 40, W: 10, H: 10}, Vec{X: 3}, true},
+		{"top edge", Rect{X: 40, Y: -6, W: 10, H: 10}, Vec{Y: -4}, true},
+		{"bottom edge", Rect{X: 40, Y: 95, W: 10, H: 10}, Vec{Y: 5}, true},
+		// near a corner the shallower axis wins
+		{"top left corner", Rect{X: -9, Y: -7, W: 10, H: 10}, Vec{X: -1}, true},
+		{"bottom right corner", Rect{X: 93, Y: 99, W: 10, H: 10}, Vec{Y: 1}, true},
+	} {
+		got, ok := Penetration(c.a, b)
+		if got != c.want || ok != c.ok {
+			t.Errorf("%s: %+v, %v, want %+v, %v", c.name, got, ok, c.want, c.ok)
+		}
+		if ok && c.a.Translate(got.X, got.Y).Overlaps(b) {
+			t.Errorf("%s: still overlapping after moving by %+v", c.name, got)
+		}
+	}
+}
+
+func TestMove(t *testing.T) {
+	floor := Body{ID: 1, Rect: Rect{X: 0, Y: 100, W: 200, H: 32}}
+	wall := Body{ID: 2, Rect: Rect{X: 100, Y: 0, W: 32, H: 100}}
+	ceiling := Body{ID: 3, Rect: Rect{X: 0, Y: 0, W: 200, H: 32}}
+
+	for _, c := range []struct {
+		name     string
+		r        Rect
+		dx, dy   int
+		solids   []Body
+		want     Rect
+		contacts []Contact
+	}{
+		{"free", Rect{X: 10, Y: 10, W: 10, H: 10}, 5, -3, []Body{floor}, Rect{X: 15, Y: 7, W: 10, H: 10}, nil},
+		{"land", Rect{X: 10, Y: 85, W: 10, H: 10}, 0, 8, []Body{floor},
+			Rect{X: 10, Y: 90, W: 10, H: 10}, []Contact{{Normal: Vec{Y: -1}, Depth: 3, Other: 1}}},
+		{"ceiling", Rect{X: 10, Y: 34, W: 10, H: 10}, 0, -6, []Body{ceiling},
+			Rect{X: 10, Y: 32, W: 10, H: 10}, []Contact{{Normal: Vec{Y: 1}, Depth: 4, Other: 3}}},
+		{"wall from the left", Rect{X: 85, Y: 50, W: 10, H: 10}, 8, 0, []Body{wall},
+			Rect{X: 90, Y: 50, W: 10, H: 10}, []Contact{{Normal: Vec{X: -1}, Depth: 3, Other: 2}}},
+		{"wall from the right", Rect{X: 134, Y: 50, W: 10, H: 10}, -4, 0, []Body{wall},
+			Rect{X: 132, Y: 50, W: 10, H: 10}, []Contact{{Normal: Vec{X: 1}, Depth: 2, Other: 2}}},
+		{"standing on the floor", Rect{X: 10, Y: 90, W: 10, H: 10}, 0, 0, []Body{floor},
+			Rect{X: 10, Y: 90, W: 10, H: 10}, nil},
+		// walking along the floor does not catch on it, falling into
+		// it lands
+		{"walk", Rect{X: 10, Y: 90, W: 10, H: 10}, 3, 1, []Body{floor},
+			Rect{X: 13, Y: 90, W: 10, H: 10}, []Contact{{Normal: Vec{Y: -1}, Depth: 1, Other: 1}}},
+		// x is resolved first: coming down diagonally at the corner of
+		// the wall clears it sideways and the fall is stopped by the
+		// floor
+		{"into the corner", Rect{X: 85, Y: 85, W: 10, H: 10}, 8, 8, []Body{floor, wall},
+			Rect{X: 90, Y: 90, W: 10, H: 10}, []Contact{
+				{Normal: Vec{X: -1}, Depth: 3, Other: 2},
+				{Normal: Vec{Y: -1}, Depth: 3, Other: 1},
+			}},
+		// diagonally past the top corner of a block only clips it on
+		// the vertical move, so it lands rather than stopping
+		{"onto the corner", Rect{X: 88, Y: 85, W: 10, H: 10}, 6, 8, []Body{{ID: 4, Rect: Rect{X: 100, Y: 100, W: 32, H: 32}}},
+			Rect{X: 94, Y: 90, W: 10, H: 10}, []Contact{{Normal: Vec{Y: -1}, Depth: 3, Other: 4}}},
+		// over the seam between two floor tiles, the first one pushes it
+		// out of both
+		{"seam", Rect{X: 25, Y: 90, W: 10, H: 10}, 5, 1, []Body{
+			{ID: 5, Rect: Rect{X: 0, Y: 100, W: 32, H: 32}},
+			{ID: 6, Rect: Rect{X: 32, Y: 100, W: 32, H: 32}},
+		}, Rect{X: 30, Y: 90, W: 10, H: 10}, []Contact{{Normal: Vec{Y: -1}, Depth: 1, Other: 5}}},
+		// stuck inside without moving takes the shortest way out
+		{"stuck", Rect{X: 10, Y: 95, W: 10, H: 10}, 0, 0, []Body{floor},
+			Rect{X: 10, Y: 90, W: 10, H: 10}, []Contact{{Normal: Vec{Y: -1}, Depth: 5, Other: 1}}},
+	} {
+		got, contacts := Move(c.r, c.dx, c.dy, c.solids)
+		if got != c.want {
+			t.Errorf("%s: ended at %+v, want %+v", c.name, got, c.want)
+		}
+		if !reflect.DeepEqual(contacts, c.contacts) {
+			t.Errorf("%s: contacts %+v, want %+v", c.name, contacts, c.contacts)
+		}
+	}
+}
+
+func TestOverlapping(t *testing.T) {
+	bodies := []Body{
+		{ID: 1, Rect: Rect{X: 0, Y: 0, W: 10, H: 10}},
+		{ID: 2, Rect: Rect{X: 10, Y: 0, W: 10, H: 10}},
+		{ID: 3, Rect: Rect{X: 5, Y: 5, W: 10, H: 10}},
+	}
+	if got := ids(Overlapping(Rect{X: 2, Y: 2, W: 5, H: 5}, bodies)); !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("overlapping %v, want [1 3]", got)
+	}
+}
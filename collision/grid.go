@@ -0,0 +1,132 @@
+package collision
+
+import "sort"
+
+// Grid is a spatial hash that buckets bodies into square cells, so a query
+// only looks at the bodies near the queried area instead of all of them.
+type Grid struct {
+	size  int
+	cells map[cell][]int
+	rects map[int]Rect
+}
+
+type cell struct {
+	x int
+	y int
+}
+
+// NewGrid returns an empty grid with cells of cellSize pixels. Cells a bit
+// larger than the typical body work best.
+func NewGrid(cellSize int) *Grid {
+	return &Grid{
+		size:  cellSize,
+		cells: map[cell][]int{},
+		rects: map[int]Rect{},
+	}
+}
+
+// Len returns the number of bodies in the grid.
+func (g *Grid) Len() int {
+	return len(g.rects)
+}
+
+// Insert adds b, replacing any body with the same ID.
+func (g *Grid) Insert(b Body) {
+	if _, ok := g.rects[b.ID]; ok {
+		g.Remove(b.ID)
+	}
+	g.rects[b.ID] = b.Rect
+	g.eachCell(b.Rect, func(c cell) {
+		g.cells[c] = append(g.cells[c], b.ID)
+	})
+}
+
+// Update moves the body with b's ID to b.Rect.
+func (g *Grid) Update(b Body) {
+	old, ok := g.rects[b.ID]
+	if ok && g.span(old) == g.span(b.Rect) {
+		g.rects[b.ID] = b.Rect
+		return
+	}
+	g.Insert(b)
+}
+
+func (g *Grid) Remove(id int) {
+	r, ok := g.rects[id]
+	if !ok {
+		return
+	}
+	g.eachCell(r, func(c cell) {
+		ids := g.cells[c]
+		for i, v := range ids {
+			if v == id {
+				ids = append(ids[:i], ids[i+1:]...)
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(g.cells, c)
+		} else {
+			g.cells[c] = ids
+		}
+	})
+	delete(g.rects, id)
+}
+
+// Query returns the bodies overlapping r, ordered by ID.
+func (g *Grid) Query(r Rect) []Body {
+	var ids []int
+	g.eachCell(r, func(c cell) {
+		ids = append(ids, g.cells[c]...)
+	})
+	sort.Ints(ids)
+
+	var bodies []Body
+	for i, id := range ids {
+		if i > 0 && ids[i-1] == id {
+			continue
+		}
+		if rect := g.rects[id]; rect.Overlaps(r) {
+			bodies = append(bodies, Body{ID: id, Rect: rect})
+		}
+	}
+	return bodies
+}
+
+type span struct {
+	x0, y0, x1, y1 int
+}
+
+// span returns the range of cells r covers.
+func (g *Grid) span(r Rect) span {
+	w, h := r.W, r.H
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+	return span{
+		x0: floorDiv(r.X, g.size),
+		y0: floorDiv(r.Y, g.size),
+		x1: floorDiv(r.X+w-1, g.size),
+		y1: floorDiv(r.Y+h-1, g.size),
+	}
+}
+
+func (g *Grid) eachCell(r Rect, f func(cell)) {
+	s := g.span(r)
+	for y := s.y0; y <= s.y1; y++ {
+		for x := s.x0; x <= s.x1; x++ {
+			f(cell{x: x, y: y})
+		}
+	}
+}
+
+func floorDiv(x, y int) int {
+	d := x / y
+	if d*y == x || x >= 0 {
+		return d
+	}
+	return d - 1
+}
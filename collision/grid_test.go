@@ -0,0 +1,167 @@
+package collision
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+const testCellSize = 128
+
+func ids(bodies []Body) []int {
+	var ids []int
+	for _, b := range bodies {
+		ids = append(ids, b.ID)
+	}
+	return ids
+}
+
+func TestGridQuery(t *testing.T) {
+	g := NewGrid(testCellSize)
+	// inserted out of order, spanning several cells and negative ones
+	g.Insert(Body{ID: 5, Rect: Rect{X: 100, Y: 0, W: 300, H: 32}})
+	g.Insert(Body{ID: 2, Rect: Rect{X: 0, Y: 0, W: 32, H: 32}})
+	g.Insert(Body{ID: 9, Rect: Rect{X: -200, Y: -50, W: 32, H: 32}})
+	g.Insert(Body{ID: 1, Rect: Rect{X: 390, Y: 10, W: 32, H: 32}})
+
+	for _, c := range []struct {
+		area Rect
+		want []int
+	}{
+		{Rect{X: 0, Y: 0, W: 500, H: 100}, []int{1, 2, 5}},
+		{Rect{X: 20, Y: 20, W: 10, H: 10}, []int{2}},
+		{Rect{X: -300, Y: -100, W: 150, H: 100}, []int{9}},
+		// same cells as body 2 but not touching it
+		{Rect{X: 40, Y: 40, W: 10, H: 10}, nil},
+		{Rect{X: 1000, Y: 1000, W: 10, H: 10}, nil},
+	} {
+		if got := ids(g.Query(c.area)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Query(%+v) = %v, want %v", c.area, got, c.want)
+		}
+	}
+	if g.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", g.Len())
+	}
+}
+
+func TestGridInsertReplaces(t *testing.T) {
+	g := NewGrid(testCellSize)
+	g.Insert(Body{ID: 1, Rect: Rect{X: 0, Y: 0, W: 32, H: 32}})
+	g.Insert(Body{ID: 1, Rect: Rect{X: 500, Y: 0, W: 32, H: 32}})
+
+	if g.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", g.Len())
+	}
+	if got := g.Query(Rect{X: 0, Y: 0, W: 32, H: 32}); len(got) != 0 {
+		t.Errorf("old position still found: %v", got)
+	}
+	if got := ids(g.Query(Rect{X: 500, Y: 0, W: 32, H: 32})); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("new position: %v", got)
+	}
+}
+
+func TestGridUpdate(t *testing.T) {
+	g := NewGrid(testCellSize)
+	g.Insert(Body{ID: 1, Rect: Rect{X: 0, Y: 0, W: 32, H: 32}})
+
+	// within the same cell
+	g.Update(Body{ID: 1, Rect: Rect{X: 10, Y: 0, W: 32, H: 32}})
+	want := []Body{{ID: 1, Rect: Rect{X: 10, Y: 0, W: 32, H: 32}}}
+	if got := g.Query(Rect{X: 0, Y: 0, W: 100, H: 100}); !reflect.DeepEqual(got, want) {
+		t.Errorf("after moving within a cell: %v, want %v", got, want)
+	}
+
+	// into other cells
+	g.Update(Body{ID: 1, Rect: Rect{X: 300, Y: 300, W: 32, H: 32}})
+	if got := g.Query(Rect{X: 0, Y: 0, W: 100, H: 100}); len(got) != 0 {
+		t.Errorf("old position still found: %v", got)
+	}
+	if got := ids(g.Query(Rect{X: 300, Y: 300, W: 1, H: 1})); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("new position: %v", got)
+	}
+
+	// an unknown body is inserted
+	g.Update(Body{ID: 2, Rect: Rect{X: 0, Y: 0, W: 32, H: 32}})
+	if g.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", g.Len())
+	}
+}
+
+func TestGridRemove(t *testing.T) {
+	g := NewGrid(testCellSize)
+	g.Insert(Body{ID: 1, Rect: Rect{X: 0, Y: 0, W: 300, H: 32}})
+	g.Insert(Body{ID: 2, Rect: Rect{X: 0, Y: 0, W: 32, H: 32}})
+	g.Remove(1)
+	g.Remove(3)
+
+	if got := ids(g.Query(Rect{X: 0, Y: 0, W: 400, H: 100})); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("Query after Remove = %v, want [2]", got)
+	}
+	g.Remove(2)
+	if g.Len() != 0 || len(g.cells) != 0 {
+		t.Errorf("Len() = %d with %d cells left, want an empty grid", g.Len(), len(g.cells))
+	}
+}
+
+// Bodies are spread at a constant density of bodiesPerScreen per screen
+// width, as they would be in a longer level, so the grid's cost per query
+// should stay flat as their number grows while a linear scan's grows.
+const (
+	bodiesPerScreen = 20
+	benchScreenW    = 640
+	benchScreenH    = 480
+	benchTile       = 32
+)
+
+// layout places n tile high bodies of one to four tiles at random.
+func layout(n int) []Body {
+	width := n / bodiesPerScreen * benchScreenW
+	r := rand.New(rand.NewSource(1))
+	bodies := make([]Body, n)
+	for i := range bodies {
+		bodies[i] = Body{
+			ID:   i + 1,
+			Rect: Rect{X: r.Intn(width), Y: r.Intn(benchScreenH), W: benchTile * (1 + r.Intn(4)), H: benchTile},
+		}
+	}
+	return bodies
+}
+
+// queryRects returns player sized rectangles spread over the level.
+func queryRects(n int) []Rect {
+	width := n / bodiesPerScreen * benchScreenW
+	r := rand.New(rand.NewSource(2))
+	rects := make([]Rect, 1024)
+	for i := range rects {
+		rects[i] = Rect{X: r.Intn(width), Y: r.Intn(benchScreenH), W: 60, H: 75}
+	}
+	return rects
+}
+
+func BenchmarkGridQuery(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000, 100000} {
+		g := NewGrid(testCellSize)
+		for _, body := range layout(n) {
+			g.Insert(body)
+		}
+		queries := queryRects(n)
+		b.Run(fmt.Sprintf("bodies=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.Query(queries[i%len(queries)])
+			}
+		})
+	}
+}
+
+func BenchmarkLinearQuery(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000, 100000} {
+		bodies := layout(n)
+		queries := queryRects(n)
+		b.Run(fmt.Sprintf("bodies=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Overlapping(queries[i%len(queries)], bodies)
+			}
+		})
+	}
+}
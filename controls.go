@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+
+	"github.com/mariuseis/go-inn/input"
+)
+
+// loadBindings reads the key bindings, using the defaults when there are
+// none or they can't be used.
+func loadBindings(path string) input.Bindings {
+	if path == "" {
+		return input.DefaultBindings()
+	}
+	b, err := input.Load(path, knownKey)
+	if err != nil {
+		log.Printf("loading controls: %v", err)
+	}
+	return b
+}
+
+// controlsScreen is the key rebinding screen. Its rows are the actions
+// followed by "reset defaults" and "back"; the columns the binding slots.
+type controlsScreen struct {
+	row, slot int
+	// capturing is set while waiting for the key to bind to the selected
+	// slot. pending is a chord already bound to another action, which is
+	// moved over when it is pressed a second time.
+	capturing bool
+	pending   input.Chord
+	message   string
+	// back is the mode the screen returns to.
+	back Mode
+}
+
+// The rows below the actions.
+var (
+	controlsResetRow = len(input.Actions)
+	controlsBackRow  = controlsResetRow + 1
+)
+
+// openControls shows the rebinding screen, returning to the current mode
+// when it is left.
+func (g *Game) openControls() {
+	g.controlsScreen = &controlsScreen{back: g.mode, message: "ENTER TO CHANGE, DELETE TO CLEAR"}
+	g.mode = ModeControls
+}
+
+func (g *Game) updateControls() {
+	s := g.controlsScreen
+	if s.capturing {
+		g.captureBinding()
+		return
+	}
+
+	rows := controlsBackRow + 1
+	n := g.menuNav()
+	switch {
+	case n.up:
+		s.row = (s.row + rows - 1) % rows
+	case n.down:
+		s.row = (s.row + 1) % rows
+	case n.left:
+		s.slot = (s.slot + input.Slots - 1) % input.Slots
+	case n.right:
+		s.slot = (s.slot + 1) % input.Slots
+	case n.back, n.tapped:
+		// keys can't be bound from a touch screen, a tap goes back
+		g.mode = s.back
+	case inpututil.IsKeyJustPressed(ebiten.KeyDelete), inpututil.IsKeyJustPressed(ebiten.KeyBackspace):
+		if s.row < len(input.Actions) {
+			g.bindings.Bind(input.Actions[s.row], s.slot, "")
+			g.saveBindings()
+		}
+	case n.confirm:
+		switch s.row {
+		case controlsResetRow:
+			g.bindings = input.DefaultBindings()
+			g.saveBindings()
+			s.message = "DEFAULTS RESTORED"
+		case controlsBackRow:
+			g.mode = s.back
+		default:
+			s.capturing = true
+			s.pending = ""
+			s.message = "PRESS A KEY, ESCAPE TO CANCEL"
+		}
+	}
+}
+
+// captureBinding waits for a key for the selected slot and binds it,
+// asking first when it is in use by another action.
+func (g *Game) captureBinding() {
+	s := g.controlsScreen
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		s.capturing = false
+		s.message = ""
+		return
+	}
+	c, ok := justPressedChord()
+	if !ok {
+		return
+	}
+	a := input.Actions[s.row]
+	if other, ok := g.bindings.Conflict(a, c); ok && c != s.pending {
+		s.pending = c
+		s.message = fmt.Sprintf("%s IS %s, AGAIN TO MOVE IT", chordLabel(c), actionLabel(other))
+		return
+	}
+	g.bindings.Bind(a, s.slot, c)
+	g.saveBindings()
+	s.capturing = false
+	s.message = ""
+}
+
+func (g *Game) saveBindings() {
+	if g.options.ControlsPath == "" {
+		return
+	}
+	if err := g.bindings.Save(g.options.ControlsPath); err != nil {
+		log.Printf("saving controls: %v", err)
+	}
+}
+
+func actionLabel(a input.Action) string {
+	return strings.ToUpper(strings.Replace(a.String(), "-", " ", -1))
+}
+
+func chordLabel(c input.Chord) string {
+	if c == "" {
+		return "-"
+	}
+	return strings.ToUpper(string(c))
+}
+
+func (g *Game) drawControls(screen *ebiten.Image) {
+	const (
+		lineHeight = smallFontSize + 10
+		x          = 64
+		slotX      = x + 13*smallFontSize
+		slotWidth  = 14 * smallFontSize
+	)
+	s := g.controlsScreen
+	screen.Fill(color.RGBA{0x20, 0x28, 0x38, 0xff})
+
+	const title = "CONTROLS"
+	text.Draw(screen, title, arcadeFont, (screenWidth-len(title)*fontSize)/2, 2*fontSize, color.White)
+
+	y := 4 * fontSize
+	for i, a := range input.Actions {
+		text.Draw(screen, actionLabel(a), smallArcadeFont, x, y+i*lineHeight, color.White)
+		for slot, c := range g.bindings[a] {
+			label := chordLabel(c)
+			if i == s.row && slot == s.slot {
+				if s.capturing && s.pending == "" {
+					label = "..."
+				}
+				label = "> " + label
+			} else {
+				label = "  " + label
+			}
+			col := color.Color(color.White)
+			if i == s.row && slot == s.slot && s.capturing {
+				col = highlightColor
+			}
+			text.Draw(screen, label, smallArcadeFont, slotX+slot*slotWidth, y+i*lineHeight, col)
+		}
+	}
+	for i, item := range []string{"RESET DEFAULTS", "BACK"} {
+		prefix := "  "
+		if s.row == controlsResetRow+i {
+			prefix = "> "
+		}
+		text.Draw(screen, prefix+item, smallArcadeFont, x-2*smallFontSize, y+(controlsResetRow+i)*lineHeight+lineHeight/2, color.White)
+	}
+
+	text.Draw(screen, s.message, smallArcadeFont, (screenWidth-len(s.message)*smallFontSize)/2, screenHeight-2*fontSize, color.White)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/mariuseis/go-inn/input"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// ebitenGamepads reads the gamepads through ebiten's standard layout
+// mapping. Gamepads ebiten has no mapping for are left out, since their
+// buttons are numbered differently by every controller and driver.
+type ebitenGamepads struct{}
+
+var _ input.GamepadSource = ebitenGamepads{}
+
+// standardButtons and standardAxes map the input package's buttons and
+// axes to ebiten's.
+var (
+	standardButtons = map[int]ebiten.StandardGamepadButton{
+		input.ButtonA:     ebiten.StandardGamepadButtonRightBottom,
+		input.ButtonB:     ebiten.StandardGamepadButtonRightRight,
+		input.ButtonX:     ebiten.StandardGamepadButtonRightLeft,
+		input.ButtonY:     ebiten.StandardGamepadButtonRightTop,
+		input.ButtonBack:  ebiten.StandardGamepadButtonCenterLeft,
+		input.ButtonStart: ebiten.StandardGamepadButtonCenterRight,
+		input.ButtonUp:    ebiten.StandardGamepadButtonLeftTop,
+		input.ButtonDown:  ebiten.StandardGamepadButtonLeftBottom,
+		input.ButtonLeft:  ebiten.StandardGamepadButtonLeftLeft,
+		input.ButtonRight: ebiten.StandardGamepadButtonLeftRight,
+	}
+	standardAxes = map[int]ebiten.StandardGamepadAxis{
+		input.AxisLeftX: ebiten.StandardGamepadAxisLeftStickHorizontal,
+		input.AxisLeftY: ebiten.StandardGamepadAxisLeftStickVertical,
+	}
+)
+
+func (ebitenGamepads) GamepadIDs() []int {
+	var ids []int
+	for _, id := range ebiten.GamepadIDs() {
+		if ebiten.IsStandardGamepadLayoutAvailable(id) {
+			ids = append(ids, int(id))
+		}
+	}
+	return ids
+}
+
+func (ebitenGamepads) Axis(id, axis int) float64 {
+	a, ok := standardAxes[axis]
+	if !ok {
+		return 0
+	}
+	return ebiten.StandardGamepadAxisValue(ebiten.GamepadID(id), a)
+}
+
+func (ebitenGamepads) ButtonPressed(id, button int) bool {
+	b, ok := standardButtons[button]
+	if !ok {
+		return false
+	}
+	return ebiten.IsStandardGamepadButtonPressed(ebiten.GamepadID(id), b)
+}
+
+// gamepadMessageTicks is how long a gamepad being plugged in or out is
+// shown.
+const gamepadMessageTicks = 2 * world.TicksPerSecond
+
+// updateGamepads samples the gamepads and notes any that came or went.
+func (g *Game) updateGamepads() {
+	connected, disconnected := g.pads.Update()
+	switch {
+	case len(connected) > 0:
+		g.gamepadMessage = "GAMEPAD CONNECTED"
+		g.gamepadMessageTicks = gamepadMessageTicks
+	case len(disconnected) > 0:
+		g.gamepadMessage = "GAMEPAD DISCONNECTED"
+		g.gamepadMessageTicks = gamepadMessageTicks
+	case g.gamepadMessageTicks > 0:
+		g.gamepadMessageTicks--
+	}
+}
@@ -3,7 +3,7 @@ module github.com/mariuseis/go-inn
 go 1.16
 
 require (
-	github.com/hajimehoshi/ebiten/v2 v2.1.7 // indirect
-	github.com/hajimehoshi/file2byteslice v0.0.0-20210813153925-5340248a8f41 // indirect
+	github.com/hajimehoshi/ebiten/v2 v2.2.7
+	github.com/hajimehoshi/oto v0.7.1 // indirect
 	golang.org/x/image v0.0.0-20210628002857-a66eb6448b8d
 )
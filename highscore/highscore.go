@@ -0,0 +1,137 @@
+// Package highscore keeps the table of the best finished runs.
+//
+// The table is stored as versioned JSON. A file that cannot be read is not
+// fatal: Load hands back an empty table together with the error, and the
+// next Save replaces the broken file.
+package highscore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mariuseis/go-inn/persist"
+)
+
+const (
+	// Version is the version of the table file.
+	Version = 1
+	// Size is the number of entries kept.
+	Size = 10
+	// MaxName is the longest name, in characters, an entry can have.
+	MaxName = 8
+)
+
+// FileName is the name of the table in the game's config directory.
+const FileName = "highscores.json"
+
+type Entry struct {
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+	// Level is the level the run ended in, Seed the run's world seed.
+	Level string `json:"level"`
+	Seed  int64  `json:"seed"`
+}
+
+// Table holds the entries best first. Equal scores keep the order they
+// were reached in.
+type Table struct {
+	Entries []Entry
+}
+
+type file struct {
+	Version int     `json:"version"`
+	Entries []Entry `json:"entries"`
+}
+
+// Qualifies reports whether a run scoring score would make the table.
+func (t *Table) Qualifies(score int) bool {
+	if score <= 0 {
+		return false
+	}
+	return len(t.Entries) < Size || score > t.Entries[len(t.Entries)-1].Score
+}
+
+// Insert adds e to the table and returns its rank, counted from 0, or -1
+// when it does not qualify.
+func (t *Table) Insert(e Entry) int {
+	if !t.Qualifies(e.Score) {
+		return -1
+	}
+	e.Name = cleanName(e.Name)
+	rank := sort.Search(len(t.Entries), func(i int) bool { return t.Entries[i].Score < e.Score })
+	t.Entries = append(t.Entries, Entry{})
+	copy(t.Entries[rank+1:], t.Entries[rank:])
+	t.Entries[rank] = e
+	if len(t.Entries) > Size {
+		t.Entries = t.Entries[:Size]
+	}
+	return rank
+}
+
+// Read decodes a table. Entries that make no sense are dropped rather than
+// failing the whole table.
+func Read(r io.Reader) (*Table, error) {
+	var f file
+	if err := persist.ReadJSON(r, Version, &f); err != nil {
+		return nil, fmt.Errorf("highscore: %w", err)
+	}
+	t := &Table{}
+	for _, e := range f.Entries {
+		if e.Score <= 0 {
+			continue
+		}
+		e.Name = cleanName(e.Name)
+		t.Entries = append(t.Entries, e)
+	}
+	sort.SliceStable(t.Entries, func(i, j int) bool { return t.Entries[i].Score > t.Entries[j].Score })
+	if len(t.Entries) > Size {
+		t.Entries = t.Entries[:Size]
+	}
+	return t, nil
+}
+
+func (t *Table) Write(w io.Writer) error {
+	entries := t.Entries
+	if entries == nil {
+		entries = []Entry{}
+	}
+	return persist.WriteJSON(w, file{Version: Version, Entries: entries})
+}
+
+// Load reads the table at path. A missing file is an empty table. Any
+// other failure also returns an empty table, along with the error.
+func Load(path string) (*Table, error) {
+	var t *Table
+	err := persist.Load(path, func(r io.Reader) (err error) {
+		t, err = Read(r)
+		return err
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return &Table{}, nil
+	}
+	if err != nil {
+		return &Table{}, err
+	}
+	return t, nil
+}
+
+func (t *Table) Save(path string) error {
+	return persist.Save(path, t.Write)
+}
+
+// cleanName trims a name and cuts it to MaxName characters, naming
+// nameless entries "???".
+func cleanName(name string) string {
+	name = strings.TrimSpace(name)
+	if r := []rune(name); len(r) > MaxName {
+		name = strings.TrimSpace(string(r[:MaxName]))
+	}
+	if name == "" {
+		return "???"
+	}
+	return name
+}
@@ -0,0 +1,192 @@
+package highscore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func scores(t *Table) []int {
+	var s []int
+	for _, e := range t.Entries {
+		s = append(s, e.Score)
+	}
+	return s
+}
+
+func TestInsertOrder(t *testing.T) {
+	tab := &Table{}
+	for _, c := range []struct {
+		score, rank int
+	}{
+		{50, 0},
+		{200, 0},
+		{100, 1},
+		{10, 3},
+		{300, 0},
+	} {
+		if rank := tab.Insert(Entry{Name: "A", Score: c.score}); rank != c.rank {
+			t.Errorf("score %d: rank %d, want %d", c.score, rank, c.rank)
+		}
+	}
+	if got, want := scores(tab), []int{300, 200, 100, 50, 10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("scores %v, want %v", got, want)
+	}
+}
+
+func TestInsertTies(t *testing.T) {
+	tab := &Table{}
+	tab.Insert(Entry{Name: "FIRST", Score: 100})
+	tab.Insert(Entry{Name: "LOWER", Score: 50})
+	if rank := tab.Insert(Entry{Name: "SECOND", Score: 100}); rank != 1 {
+		t.Errorf("tie ranked %d, want 1, below the earlier entry", rank)
+	}
+	var names []string
+	for _, e := range tab.Entries {
+		names = append(names, e.Name)
+	}
+	if want := []string{"FIRST", "SECOND", "LOWER"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("names %v, want %v", names, want)
+	}
+}
+
+func TestInsertTruncates(t *testing.T) {
+	tab := &Table{}
+	for i := 1; i <= Size+3; i++ {
+		tab.Insert(Entry{Name: "A", Score: i * 10})
+	}
+	if len(tab.Entries) != Size {
+		t.Fatalf("%d entries, want %d", len(tab.Entries), Size)
+	}
+	if first, last := tab.Entries[0].Score, tab.Entries[Size-1].Score; first != (Size+3)*10 || last != 40 {
+		t.Errorf("scores from %d to %d, want %d to 40", first, last, (Size+3)*10)
+	}
+	if rank := tab.Insert(Entry{Name: "LOW", Score: 20}); rank != -1 {
+		t.Errorf("a score below the table ranked %d", rank)
+	}
+	if len(tab.Entries) != Size {
+		t.Errorf("%d entries after a score that did not qualify", len(tab.Entries))
+	}
+}
+
+func TestQualifies(t *testing.T) {
+	full := &Table{}
+	for i := 0; i < Size; i++ {
+		full.Insert(Entry{Score: 50 + i})
+	}
+	for _, c := range []struct {
+		name  string
+		table *Table
+		score int
+		want  bool
+	}{
+		{"empty, nothing scored", &Table{}, 0, false},
+		{"empty, negative", &Table{}, -5, false},
+		{"empty", &Table{}, 1, true},
+		{"not full", &Table{Entries: []Entry{{Score: 100}}}, 1, true},
+		{"full, below the last", full, 49, false},
+		{"full, tie with the last", full, 50, false},
+		{"full, above the last", full, 51, true},
+	} {
+		if got := c.table.Qualifies(c.score); got != c.want {
+			t.Errorf("%s: Qualifies(%d) = %v, want %v", c.name, c.score, got, c.want)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	for _, c := range []struct {
+		name, want string
+	}{
+		{"ANN", "ANN"},
+		{"  BOB  ", "BOB"},
+		{"", "???"},
+		{"   ", "???"},
+		{"ABCDEFGHIJ", "ABCDEFGH"},
+		{"ABCDEFG HI", "ABCDEFG"},
+	} {
+		tab := &Table{}
+		tab.Insert(Entry{Name: c.name, Score: 1})
+		if got := tab.Entries[0].Name; got != c.want {
+			t.Errorf("name %q stored as %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	tab, err := Read(strings.NewReader(`{"version": 1, "entries": [
+		{"name": "LOW", "score": 10},
+		{"name": "ZERO", "score": 0},
+		{"name": "", "score": 30},
+		{"name": "NEGATIVE", "score": -5},
+		{"name": "HIGH", "score": 20}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Entry{{Name: "???", Score: 30}, {Name: "HIGH", Score: 20}, {Name: "LOW", Score: 10}}
+	if !reflect.DeepEqual(tab.Entries, want) {
+		t.Errorf("entries %+v, want %+v", tab.Entries, want)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	tab, err := Load(filepath.Join(dir, "missing.json"))
+	if err != nil || tab == nil || len(tab.Entries) != 0 {
+		t.Errorf("missing file: %v, %v, want an empty table and no error", tab, err)
+	}
+
+	for _, c := range []struct {
+		name, data string
+	}{
+		{"truncated", `{"version": 1, "entries": [{"name": "A", "sc`},
+		{"not json", "\x00\x01garbage"},
+		{"too new", `{"version": 99, "entries": []}`},
+		{"no version", `{"entries": [{"name": "A", "score": 5}]}`},
+	} {
+		path := filepath.Join(dir, c.name+".json")
+		if err := os.WriteFile(path, []byte(c.data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		tab, err := Load(path)
+		if err == nil || errors.Is(err, os.ErrNotExist) {
+			t.Errorf("%s: error %v", c.name, err)
+		}
+		if tab == nil || len(tab.Entries) != 0 {
+			t.Errorf("%s: table %v, want an empty one", c.name, tab)
+			continue
+		}
+
+		// the next save replaces the broken file
+		tab.Insert(Entry{Name: "NEW", Score: 7})
+		if err := tab.Save(path); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := Load(path); err != nil || !reflect.DeepEqual(got.Entries, tab.Entries) {
+			t.Errorf("%s: after saving: %v, %v", c.name, got, err)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tab := &Table{}
+	tab.Insert(Entry{Name: "ANN", Score: 120, Level: "meadow", Seed: 7})
+	tab.Insert(Entry{Name: "BOB", Score: 80, Level: "cellar", Seed: -3})
+
+	path := filepath.Join(t.TempDir(), "sub", FileName)
+	if err := tab.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, tab) {
+		t.Errorf("got %+v, want %+v", got, tab)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+
+	"github.com/mariuseis/go-inn/highscore"
+	"github.com/mariuseis/go-inn/input"
+)
+
+var highlightColor = color.RGBA{0xff, 0xd7, 0x00, 0xff}
+
+// loadHighScores reads the table, starting over with an empty one when the
+// file is damaged.
+func loadHighScores(path string) *highscore.Table {
+	if path == "" {
+		return &highscore.Table{}
+	}
+	t, err := highscore.Load(path)
+	if err != nil {
+		log.Printf("loading high scores: %v", err)
+	}
+	return t
+}
+
+// endRun finishes the run and moves on to mode, asking for a name first
+// when the score makes the high-score table. Replays never enter the
+// table.
+func (g *Game) endRun(mode Mode) {
+	g.mode = mode
+	g.discardSave()
+	if g.playback != nil || !g.highScores.Qualifies(g.score().Total()) {
+		return
+	}
+	g.afterNameEntry = mode
+	g.playerName = ""
+	g.nameEntryTicks = 0
+	g.mode = ModeNameEntry
+}
+
+func (g *Game) updateNameEntry() {
+	g.nameEntryTicks++
+	for _, r := range ebiten.InputChars() {
+		r = unicode.ToUpper(r)
+		if len(g.playerName) >= highscore.MaxName || r > unicode.MaxASCII {
+			continue
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || (r == ' ' && g.playerName != "") {
+			g.playerName += string(r)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && g.playerName != "" {
+		g.playerName = g.playerName[:len(g.playerName)-1]
+	}
+	_, _, tapped := justTapped()
+	if !inpututil.IsKeyJustPressed(ebiten.KeyEnter) && g.pads.JustPressed()&input.PadStart == 0 && !tapped {
+		return
+	}
+
+	g.highScoreRank = g.highScores.Insert(highscore.Entry{
+		Name:  g.playerName,
+		Score: g.score().Total(),
+		Level: g.level().Name,
+		Seed:  g.seed,
+	})
+	if g.options.HighScorePath != "" {
+		if err := g.highScores.Save(g.options.HighScorePath); err != nil {
+			log.Printf("saving high scores: %v", err)
+		}
+	}
+	g.mode = g.afterNameEntry
+}
+
+func (g *Game) drawNameEntry(screen *ebiten.Image) {
+	name := g.playerName
+	// blinking cursor
+	if len(name) < highscore.MaxName && g.nameEntryTicks/20%2 == 0 {
+		name += "_"
+	}
+	texts := []string{
+		"", "NEW HIGH SCORE!", "",
+		fmt.Sprintf("%d", g.score().Total()), "",
+		"ENTER YOUR NAME",
+		fmt.Sprintf("%-*s", highscore.MaxName, name),
+	}
+	for i, l := range texts {
+		x := (screenWidth - len(l)*fontSize) / 2
+		text.Draw(screen, l, arcadeFont, x, (i+4)*fontSize, color.White)
+	}
+}
+
+// drawHighScores draws the table centred below y, highlighting the entry
+// made this run.
+func (g *Game) drawHighScores(screen *ebiten.Image, y int) {
+	const lineHeight = smallFontSize + 4
+
+	title := "HIGH SCORES"
+	text.Draw(screen, title, smallArcadeFont, (screenWidth-len(title)*smallFontSize)/2, y, color.White)
+	if len(g.highScores.Entries) == 0 {
+		l := "NO SCORES YET"
+		text.Draw(screen, l, smallArcadeFont, (screenWidth-len(l)*smallFontSize)/2, y+2*lineHeight, color.White)
+		return
+	}
+	for i, e := range g.highScores.Entries {
+		l := fmt.Sprintf("%2d %-*s %6d %-8.8s", i+1, highscore.MaxName, e.Name, e.Score, e.Level)
+		c := color.Color(color.White)
+		if i == g.highScoreRank {
+			c = highlightColor
+		}
+		text.Draw(screen, l, smallArcadeFont, (screenWidth-len(l)*smallFontSize)/2, y+(i+2)*lineHeight, c)
+	}
+}
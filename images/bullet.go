@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Bullet_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00 \x00\x00\x00 \b\x06\x00\x00\x00szz\xf4\x00\x00\x00\x01sRGB\x00\xae\xce\x1c\xe9\x00\x00\x00\x93IDATXGcd \x01\xf4KI\xfd'Fy\xe1\xb3g\x8cĨ\x03\xa9!Z!H\xf1\xa8\x03\xe8\x1e\x02\xe8\x16\xa6j\xca\x10\x15\xb5\xb3\xaf?AQ\x87/M\xe0M\x03#\xd3\x01ȾF\x0frn\rS\xa2\xa2\xe0\xeb\x8d\xd3(ꐣ\x04=:0\xa2`\xd4\x01t\x0f\x01|)\x9d\xd88'\x940\x90\xd3\x04z\x16e\x1cu\xc0h\b\x8c\x86\xc0h\b\fx\b\xa0\x97bt/\x8aG\x1d0\xe8B\x00\xd9A#\xb3M8\xa8B\x00_\xfa\xc0\xd7\b\x19\xed\x1b\x0e\xa9\x10\x00\x00\xc0e絡-\x02D\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Enemy_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00<\x00\x00\x00K\b\x06\x00\x00\x00,\xdb\xe4\v\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00.#\x00\x00.#\x01x\xa5?v\x00\x00\x00\atIME\a\xe5\t\x1d\r\x1b+(ll+\x00\x00\x18\x03IDATx\xda͜yxU\xd5\xd5\xff?\xe7\x9e;\xe4ޛ\x81$7\t!\x13$$@\ba\x9e\xa1\f\x82UP*\x0e\xa0\xa0\x16\xab?\xfbVŶj۷\xb6jk\xadc߷\xb5h\xa9ֱ-C\x8b\x80\x802U\x05\x04\x99\x87 \x01BB\xe69\xb9!$\xb9\xb9\xf39\xe7\xfd㜛{\x13B\b\x93?\xd7\xf3\x9c\xe7ܜ\xb3\xcf\xde\xfb\xbb\xd6\xdak\xad\xbd\xf6\xde\x11b\xb9~dW>\x83\xf2\x02J\xf2\xf3\xf6\b:\xc1:`ƍc\t\xb3\xfa\xc1\x06(\xd0^\nf3\xb8\\\x00\xd8\xc2ﾎ\xbdQI\u007f]kw7\xa7m^\xbbz\xa3\xc7\xe3\xcd=r,\x9f955\xafOz\xf0Ǐ\x82\x1d\xbc*HZZ\xc0`\xc0\x16q\xcfu\a\v \\/\tەM\xd4\xef\xfa\xfc\xfd\xcd[\xb6.\x992y\"\xbbv\xeda\xc5\xda\"\xe6\xceJ(\xaf\xa9k\x94\x1a\x9bd\x83$\xe3C\xc1\x9b\x94hp\xe6\f\x19\xb8oP\xd6\xc0ꁙ\x83v٦ܰ\x17$l\xc2-\xdf~\xc0ve/\xe0\x81\xfa\xe2\xef}\xb1v\xf5[\u007fy糄\xa8\b\x10\x04\xb0Z@\xa7\x13H\xef\x9f\xcc\xc0\x8c\xfe\xd8bcis80\x9bÐ%\x99ʪj\nϖ\xd1\xe6pVϞ9y\xf3\x8d\xf7\xdc\xffk\xe2\x067\u0604I\xdf>\xc0\xf6s\xff\x80\xe8$\xbd\\pxΑ\x83{\x1f,,*\xbe\xb1\xb4\xbc*l\xe4\xf0lrs\x87\x13k\x8bG\x14\xf5H~\x1f\xa60\v:QD\x10Ŏ\xef\x15I\x06E\xc6\xeb\xf5\xd0|\xae\x91Ç\x0e\x91w\xe2t͝\xf3o\xf9\xe9\xe0\xf9\x8b\xff\r\x16l\xc2w\xbe\x1d\x80\xed\xf2F\x9cyGo\xf9jϮ_\x94\x94\x96O\xce\x19:D\x18\x96;\x82ȸ\xbej\x01IR\xef\xb2\f:\x1d\x88\xa2*rI\x02\x9d\b\x8a\xf6\xdc\xef\xd7z%\x00PWY\xca\xea\xd5k\x88\x8d\xed\xf3\xf9\u0085\x8b\x96\x9e\xca\xcf;=kњ\xeb\a\xd8.m\x00\x87cPS~^Z[KKE\xffi7\x14ج\v\x83\uf56d4\xed\xfd\xfc\x8e\x83\a\xf6\xff\xc6\xe9t\xe5\x8c\x1a5\x8a\xe4\x94\xfe\x18\"\xfb\x80N\x00IVA\xa2\x80\xa2\x84\xb4\xaa\xbbx\xa3\x8a\f\b\xea]\x10\xf0\xbb]|\xbai=\xc7O\x14\x9cz\xf6O\u007f\x9af\x8b{\xc0~]\x00\xdb\x1d\xab9\xb9uӯ\xb6\xff狧\xfa\xa7&\xf7\xa9\xac\xaeE\xaf\xd7\xef~\xe4\x8f\u007f\x9e\x87\xce\xe4w\xe5\xed\xbf\xebȡ\xfd?:\xdf\xd2:v\xd4ȑ\xf4K\x19\x00\xe1\xe1\xe0\xf5\x05\x01j\x92B\x10@Vz\xd7#\r\xa8\xfa\x8d\f\x8a\x82\xe2\xf7\xf3\xc5g[8\x91_`\xbf\xff\xbeŋb\xc6N\xf8\x8f\xcdx\xfb\xb5\x03l\xafXN\xf5\x99\xfc?\u007f\xb1c\xe7\xd2Y\xb3n ֖\x80\xd7\xe3f\xc5\xca\x15\x8c\x1e9|\x9d\x02\xb1\a\x0e\x1e\x996m\xea$\x86\x8d\x9d\f&#\xf8|\xaa\x8a\n\x82&A\x05\x14UX\xaaĺ\x00\x16\x02\x00C%\xaf1F\xd0~+\xda{Y\x1d\x12y\x87\xf6\xf2\u128fx\xf2'\x8flI\x9e:\xf3\xc1\x86\x03{j\xdb\xdb\x1d\xfd\xcb\xcbʜ9\xb9\xc3\xcf\xdb&\xcf\xf3^j\x9cw\x0fX\xf9\x8c\x15\xcf\xfc\xb40s`zfN\xeeH\x9cN\a}\xfa\xc4RVZĚ\x8f60bx6\xe3\xc6O 6e\x00\xf8%M*:zMB\xc8ﮀ\x03\xcfB\xb5CQ\xd4\xf1m0rl\xefNV\xfd{\x03\xa3\x86\x0f9\u007f\xa6\xa8L\x89\x8c\xb0F\x87\x85\x99\x94\x91\xc3s\xdce\xe5\x95\xfb\x81\xb7\xef\xfeͫ\xabm\u008d\xdd6}\x91\xc0COm]\x83\xc1h4\x10\x19\x15ų/\xfc\x9d\x97\x9f\u007f\x88\xe4\x94\x01\xc4\xc5Ő\x91\x9eAlj\xba\xaa\xbe\x02\x97\a6@\xb2\xac\xdeu:\xf5\n\x00\x0fԥI\x15AP\xdf\v:\x90%FN\x9eNCC\x03\u007fxcW\x9f\xb5+\u007fEd\xf60\xb0Z\x05\xdcn\xf3\x84\xc6\xc6\x19\xc7\xf7\xee\x9a\xf1\xe1\xd3?~\xa8*\xef\xf9\x1b\x93G<+wm\xf6\"=\rG\x10\x84\xfc\xcfw\x1d\xc5h022'\x96O>݊^\xafg\xf6\xac\x1b8u\xfa\xb4fa\x05.I\x1d\x12T\xd4K\x91;$\xe6s\xb6\xd3f\xaf\xa7\xa1\xac\x98\xa6\xaa2\xaa\xcf\x16PWZH[C\x8d\xc6w\xbdƘ\x10\xd0~\x89\x99\xb3n\xe2\a\x8br)+9\v\xd6~@,\x84%B\xca(\x86/\xfc!\x93&N\xb8a\xf9\xf2\xe5\xdb\xed҆\xa0ֺ?\xc2^\xb1\x1c\xd1\xd2M\x1f_\xfd\xed\xdb,\xbe-+y\xd7\xde\xda\xd9q1\nsn\xbe\x11\xa7\xb3\x9d\x94\xd4T\xe2\xfbg08;'\xd8\x11\x84\x9e\xc1\xea\x04\xb5\x88\x02(2~\x8f\x1b\x9f\xc7E\xe1\xe9\x13\x1c:x\x80\xe6sM\xe8D\x1dF\xa3\x91\x88\x88(\x8aΜ\xa6\xa4\xa4\x98Ң\x02L\x82B\xa4-A3^Z{\x02\x88@z\xfa\x00v\xec\xd8Iv\xdfh\xc4\xf8\xcc\xc0\x98\x00tĦ'\xa1knL\u007f\xeb\x95?\x98\x9e}⻆e˟<Kt\x1cD\x86_\xdcJ\u007f\xfa\xfe\xbc\xfe\xbbv\xef+\xfd\xea`#\xcb__Jl\\\x02&\x939h\x89\x05zay\x95\x0e\u007f\xeb\xf7\xb8\xf1\xb8\x9d\xd4VW\xd2\xdaڊ\xcd\x16Gj\xc6`H\xea\a\xe6\x98\x10ekW'\x13\x95\x95lߺ\x89\xe8\xe8h\xc6Ξ\xa3>\x13\x02\fV5\xc4^_\xcd\xee/\xbfd\xfe#\x8fCTz\x88&Ix\xf3\xf70\xedƧii\x83\xb97\x18\xbe~\xed\x8de\x93I\xee\xef\xe8V\xc2\x00\xfdb\x9a\xe2\xabk\xedK\u007f\xf7\xcc\x0fILJ\xc5\x18\x11\x19T1EV}\xacp)\x95\x16@\x96p\xb5\xb5P_[ŉ\xe3\xc7IJN!s\xd6MD\r\xb9\x01b2\xc0\x10\x0fD\x00\xe1ڽ\x0f\x18\xac\x10\x1bNFz\x06EǏa\x94%\"R\xfb\x83ǣ\xe1U\x19i\x0e\xb3P^VL\xc1\xe1\x83dM\x9a\xae\xb5\xa9\a\xf4\x88qV\xfa\xe9\xaa\xf8xk\x05\x15\xd5rB\xff\xb8\xf3\xfẻ\x93\xbf\xecv\f\x97\x1cx\xda\x18\x15\x19\xb1~\xc1\x1dsH\x1b\x9c\x83\xde\x14\xa6\xbe\x90\xe5\x0e\xdfxI\xb0\x82\xcam\x9f\xdbŉ\xaf\x8fq\xec\xe81f\u07be\x80\xbeӗ\x80e(`\x05̀Q\xbb\f\xa8ʪ׀\xa7B\xdc`\xa6\xcf_\xc0\xb6m\xdbi++\x01\x93I\x1b&\xc1nO\x9a4\x95/v\xed\xc3\u007fj\xbf֨\x17\xf0\x80\x10\xc1\x9c\xbb\xefe\xf2hhk\x87\xd6ֶ\x16\xbcn\xf9\x02\tە\xb3\x94\x1e\xdc\xf8\xbf\xc5%\xa5\xb7̻m>\xa2\xde\xd0\xd9]\x80\xe6k;P]\\\xa1\xbd^\xeak*9z\xec\x18w\xfd\xd7RH\x98\x10\x02LGg\x1b\xa0\x84\xfc\x1d\xb8\x8c\x10n&Vig\xfd\xfa\xf5\x8c\x998E\xed\x83\x16\x92\n(\xe8\x04\x810#\xac[\xf71\x93o\x99\x05\x92\x13t\xb2\xca\xd0\b3\x96\xd6r\x04\xa5\xa2\xf0\xe7\xbf\xfc\xe5ψKm\xbbP\xc2\xe5[ټ\xed\xb3\xbb\xfb&\xc4a\f\x8f\n\x02\x04\xd5J\n\x02=\x1a\xab\x8e\xc7\nnW;_\xee\xdeͭ\xf3n\x83\xb8q\x9a\xf4B\xc1\x06˪\x97\xdc\r\xf0HR'LA\x91\x15N\x1fأ\x0e+!\x18z\x02\x8c\x181\x8a\x92\xb2\x06*\xfe\xb3\x05Ο\xd7\xea\x00\xb00,7\x97ܡ\x19;\xc8\x18T\x83\xdc~\xa1[:\xf6\xd5\xce\xf4ʪ\xfa\xf8\xb1c\xc7j\x16\xb6+\xc0^\xb8\"\x00I\xa2\xb6\xa6\x92v\xa7\x8b\x881\xb35\xc9\xf6\xe4\xaf\x05\r\xb0\x044\x01\xcd\x04\xc6#\x91I̜\xf1\x9d\xa0;\f\x04#\xdao\x9d(\x12\x11a\xe4\xe1\xc7\xff\xcak\xff\xfd\v\x8e\xad\xfa\x00p\x03\x021\xb1q\x88:]:~7\b\xba\v{\xd0\xd2\xd2r{\x9c\xad\x0f}\xfb%\xab\xe1\xa2\xd2\xcb\x18\xb8CXJǽ\xb0\xb0\x90qcG\x03\t!R\xbcX}\n\xd0J\xfb\xd1\xd5<w\xcfl\x1e\xbfy\x02\xde\x13k4\x8d\b'k\xd4x\\.7\xee榎X[\x96%$\xbf\x8f\xbd_\xed\xe6\xe01/#rL\xa4\x0fH\xe1\xadw\xd7P\xb7s# \x10f\xb6\x10\x15\x15\x99\x86\xde\f\x82\xb93`\xbbc5\xf9\xa7\xce\xdc\xde'*\x12SDT\x88\nw\xed\x98\x16\xefꄠ\x9f\xedRF\x96$*\xabjHM\x1b\xd0E+\xba\xf2XF54~<_\xff\x87yw=O\x9c-\x86\xaf\x0e\xc3\xdc\xdb\u007f\x054\x00\x16HI\xc1\xe5vS]U\xae\x1a-YF\xf2\xfb9[x\x9a]{\x0e\xf2\xf6\xeb\xdf\xe7ɟ<\xc6\xe8ѣ\x89\xe9\x13\xc6?V\xac\x06\xdcH~?\x95U\xb5\x1e\x14/\xf8\xdb:\xb7\xde~\xe6\x14~\xbf4dʤ\xf1ꜵ\xa7H*0\xa3Q\x94.BS\xbf\xf1\xfb\xbcȒLTJ\x00pw\xe3>0^\x8d\x80BeE).7\xfc\xf3\xa3\n\xaa\xed\xd0\xdc\x02H絲f\xac\x163\x05\x05\x05\x1d\xed\xfa%?\x9b\xb7}\xce\xd0\xc1\x03IHLB\x10\xc0h0\"\xc9\n\xdbw\xd6S\xbbc#Օe465\xfb\x10\x04\xf0K\x9dc\xe9\xf2\xd2\xe2\xc8\xeaچ\xc8\U0010cb20\xeb\t\xcc^.\xa6\xba\x82\x16\b\x04@k\xd6\xdb\xeb\xf5\x90\x90`\x83ā\x9a\x14\xbb\xab$\x10\x82\xa9\x94\x92:\x00\xa3\x01\xceV\xa8#9%\x11\x10\xad\xda[#}\xfb&\x90w<\x9f\xb9~?\xb2߇\xcf\xeb\xc1h\xd0\xf3\xfe\xaaS\x14\x14\x95\x12o\x8b!\xfft5-mp\xff\x82\xa1,\xfb\xcbߨ\xa8rq\xff=\xd3=H\x12\x84\x85u\x96\xb0\xd9b\xcdL\x88\x8fљ\xad\x11\xc1\x87b\x17\x15\fH\x16Tf\\ a\x95$\xc9OCc\x13\xaa\xb1R\xba\\\xddYc\x03\xa6\xdc\t\xdcuk*\x960\xc8J\x827\xff\xb8\x14觕\xf5p\xee\\3\xa7\xceԡx<\xa0@Iq\x11.\x97\x87\xdf\xffz.i)\x89\x88\xa2\x8e\xe9SrX\xb3\xf9\x1d\xee\xfb\xfd2^|\xe5eb\xfa\x80\x80\xb0\tQ\x0f\x84\x05%l\x977R\xf4\xc9\xfay\x16s\x18\xa2Ţ\x85\x8d\xbd0X]\x8b(*\x10\xa1\xd3\xd8\xef*ݎ\xe0\xba˳$\x1e]\xf6\x16K\x96\xec\xc3j\x8b\x87\xb4)\xc12~\a\xa7\nΒ\x98`EQd\xbc^7G\x8e\xe6\xf1\xe4\x93O`LMc\xd2\x1c\xa7\xea\xb2⒀>\x80\x1f\"#IK鋽\xa9I\xa6\xdd\x01Vc\x88J{\xbc\xb4\xb7\xb7g\r\x1e\x94\xa9\x8e\xdf@~\xe9rI\x1b_\x16k\x04--m\x80\xeb\"\\ꎙ: \x19\xeb\xe8\x85\x04g\xae~\xc0\x03uu\x14\x95\xb4\xf0ݙ9\x00\xd4\xd7U3n\xech\x8c\xc3nR5&J\xea\xa21\x12H\x12\x16K\x18Ç\xe7\xee\xc7\xeb\x03\xab\x14\xa2Ң\x8e\xaa\xaa*\xbd\xae\xc3\x18]\xa4_\xbd\xf1.:\x1dzc\x18\xc3s\xb3\xc1\xd7ƅ\x06\xab\xabj\x87\x1a\x00\x03\x9d\xa7\xe92\xd0N\xdd\xd9\x02\xfc~\xc8\xc9\xc9F\x10\x04N\x9d:Ell,jx\xaaӾQ4\x069\xa14\x8f\x95o\xbeN\xf3\xf9\xd6W\xb2\xa7\xcd\xdae\x8b\xb9\x8f\xbcU\xef\x05\x01ی\xb7\xe3p\xb47\xd77\u0603\x89\xb7+\x920\xda\xdc\x15\xa2\"#iط;\x04T\xa8\x04\xba\xba\xa7\xaee\x02\xcf\xdc\xd0T̺\xf5\x1f\x93\x98\xa0#))\x05A\xd0!K2%\xc5Ś\x06\xc9\xc0y\x943\xbb\xd9\xfe\xfaӼ\xb9\xf4>\xa6\xcdx\x9c\xb5\x9bN\xb0\xf0\xce\xf9\xcbl\xb6%\x00\xccZ\xb4\xa6\xb3\x95\x8e\x8f\xb3\xd5VU׆DWWH\x92\x04:\x1d\x83\x86d\xf3\xee\xbb\x1f\xf0df6$N\xefF\x9a]\xff\x0e\x80\fD\\\x1e\xf0V\x90\xbfc;;\xbf*\xe7\xc1{\xa7\x11\x97\xd0\x0fEQ\x187~\x02?\xfd\xf9\xff\xe0\xf6x\xc8\xce\x1e\xca֭\xdb\xf8\xe0_\x85\f\x19\b\xf7.\xbc\x89\xea\xbaFJ\xca]R\xbf\xe4ԚЮubsF\xc6\xc0\xbd\xf5\rvp:\xaf\x1co\a\x06\x81\x88\xc8\x18fϚ\xce\xca\xe5ˀ3!\x05B\xe6\xb5\x174$\xabR\xc5\x01\xd4P\xb9\xeb3^\xfc\xc3Jn\xbfe8\xb3\xbf;\x17\xf4z\x04Q$.\xa1\x1f\xcf?\xf3\x10\x9fl\xd9\xc1\xf3/.\xa3\xb4\xbc\x9a\xfe\xc9\xf0\xb3\x9f\xfe\x80\xa9\x0f?\xce\xf7\xef]\x80N@r\xb6\xb7uR\xd5N\x12N\xe9?0\xaf\xae\xa1I*/-\x12Ӳs\xd5\x04\xdd\x15\x93\x82h00h\xf0P\xe2\xe2\x12\xf8\xe8\x85\xe7\xb8s\xc9C\x90<QkV\xa2\xf3\x8c\xc9\x1f\"Y'4\x97\xf1\xf5\xf6OY\xb7a\v?\xf9\xd1\xf7\x187y\xba6T\x84\x8e<Xzf6/\xbe\xf0\x1c\x1e\xb7\v\xb7\xdb\xc5\xdau\x1f\xf3\xf1\x86Oy\xd0\x16\xc7\xee={IK\t\xdb\x14;\xf96\xe0\x0f\xdd\x03֥\xa4\xd4g\fHv\xec߿?*m\xd8H\x15p\xe7\xd8\xe02\xf0* \x8a\x18\f&\xe2\xe2\xfb2a\xc2x\x96\xbd\xfc\"\x13ǏaĨq\xe8\af\x82ɬvAv\x83\xd7\vN'ޚ*ʊ\v9|\xe4\x18ť\x15\xfc\xd7\xc3\x0f\xd07=+\xb8z\x11\x1a\xe1\xe9tX\xac\x11\x18\fF\xc2\xcc\x16\xd2\a\xa4\xf2\xbf\u007fك\xcb\xfd\xd6׃2\a\x1c{\xe9\xcd7\x1e\xb1\tS:u\xabs\xd6ҚLVfFš#Ǉ!\xcb\xc1\xecBhV\xb1[\xf0!e:\x86\xa4\x0e\x14\x19\x9dф\xe0\xf7\x11\x9f\x90Ă\x05wr\xead>\x1f|\xf0\x1eq\xb6\x18\xe2\xe3\xe3\xb1\xdb\xedX\xcc\x16\xda\x1c\x0e\x9c.\x17\x92$\xa1\x13tL\x99<\x89{\x16ߏ`2\xa9\x93\x18\x9dإ}\r\xb8(\xa27\x18\x90e\x99ľ}\x196X\xa8z\xe6\xc3\x0f\x87\xa3\xb3b\x13f]\xd0\xd3N\x80m\xc2\x14\x8el\xfd\xe1\xca\xf7\xfe\xf1\xc5K\xde\xe6s\x18\xa3\xfa\x04өWB\xa2\b\x8a\x82\xa03b4\x9aH0\x85\x11\x1b\x1bϘ\xb1N\x9a\xcf\xd9\x11\x04HJJ\xc6`0\xa0(\n\x11\x91QX\xac\x11\x88\x16k0\x16\xe8\x92\xe1\xe8D\x82\x0e\x90\x11\xf4\x06DI\"2*\n\x05\xbcTVb\xeb\xffX\xb7\x9f\\\x90\x97N\x1b\x90\xf5\xcf\xf4\xfe\x96\xdfV\x96\x97\x183F\x8c\xb9:\xc0\x8a\xa2-\xa0\x89\x9a\x8b5\xa07\x99\x88\xb0X\x89\x88\xd6҇\xfa@\x17B&\xf5\xb2\xdc%\xd2\xebi\x12\xa3\x03EB\x14\xf5\x98La\xb49\x14COAӅ\xac\xcb\xfa^Ոa\x83\xb7}\xb2y\xab\xcaa\x9dN\x8b\x99\xe9a,\v\xc12\x9d\x00\a:\xa4h\x00\x84\xe0]'\xaaR\x94\xb5w\xb2\xacy\xa4P\xcb\xddK\xf7(\x04Cپqf'qq\xbd\al\x13\x062t\xe8\xd0\xff9u\xa6\x1a{UY/2\x93WAW\x18\xdbt\x8fD\x87\xc1h$<\xdc\"K\xd5U\xbd\a\f0d\xeem\xbb\xd2\xfb\xc7U\x1e:t\xf0\xe2\xe3\xe7[H\x16\x8b\x95\x8c\x01\xa9:1!\xf1\xf2\x00\xdbLw0jDΦS\xa7\x8b\xf0\xb7\x9c\x0ff5\xae\xa3\xb0;\xd1e\xa6\xcf\x02Z\xa8\xc8\ne\x15\xd5I\xa5\xfbw\xdf`\xafX\xde{\xc0\x00Æ宪kh\xe2ؑ\x03`0|CHC\x00\xe8\x84\xcbPy\xa1#u\xac\x17\xc5\xf0\u007f}\xb4~Mɉ#7ٕ=\xbd\a\xdcw\xe2\xd4=\xd1}\"\x8aw\xefٯ\xfa\xc1o\f,\xc1ࢷ\x12\x0e,\xd0\x013gLe\xe9c\x8fFo\xdb\xfeٖ\xb5\xbf\xff\xc9\xcf\xec\xcaV\xecʁK\x03\xb6\x85\xdd\xc9\xc4q\xa3\xbehs\xb4\xe3>߬ZT\xe9*\\T\xe7\x1eҭ\xd9\x0f\x80\x85\x8bfR.\xa0\x8eT246\xd4\xf1\u128f\xb0\xe6\x8e\xe0G/\xbcDKK\xeb\xab\x1f>\xfd\xc4n5\xe5{\t\xc0\x00\xe9\x19\x19+SS\xfaQ[]\xa1E5Wa\xc0\x02\x19N\xbd\xd8)\x1e\xee\x96:\x96szQoȂ^\xf3\xb9s\xb8\xdd2k^\xfa\x1dm\xa7Or\xe7]w1e\xd2\xc4)\x85\x1bW\x9b{\x058-w\xf4΄x[s\x9b\xa3M\xe3\xf8U\xf8\x11\xed[\u007fk\v\x8a\xc7Mk}-\xces\xf6\x8e\xb9sp\xb6\xa8\x04\xfdq\xaf\x9a\v\x16\x8a\x89\x89e\u0098!\r\xfd\xfa%~\xfa\xc1\x87\x1fJ\x87\x0f\x1d\xc0no\\\x99u\xcb\xfc\x8e\xb4K\xcf[\x0f\x13S\x10\x10j[[Z\xa2;\xb2\x98W\x829\xa0\xaa\xb2\xc2\xc9\xfc<DQ\xa4o\xdfD\x8a\x8b\v\x199m\xb6\xba*\xa8\xeb\x92\xf2\xbd\\\xff/\b\xf8\xfc>\x92\xfa%\x1e\x9c\xfc\xe0\x8fo\x1dqdO\xec\xc9\xfc\xe3\x96q\xf7\xdc_\x89.\b\xb3g\x1d\xad(!\xb1_\xe2\xe1\x86F\xfbU\x86\x98\xea\xcd\xd3\xd6\xc2\xf9\xe6fΞ-&\"*\x9a-\xdb>\xd7\xc2I\xae\\{\x02\x8c\x11E\xfc~?>\xbf\xcf\a1\xa4\x8dy\xbeiΒ\r\x95\x18\x13\xc1\xe7\xe9%\xe0ԑ8\x9d\xedN\x97\xcbu\xf5\xaeIQ\xd0\x1b\f\xb49\x1c\x14\x14\x16c\xb2D\xe0\xf7K\xd0\xde~\xa1\xe6\\b\xbd\xee\x02f\x86\xa4\x8e\xc3\xc3\xc3\r\xeaJF\b\x19l\xbd\x04\\y\x1c\x8f\xc7\x1b\x99\x9a\x9ar\xe5\xea\x1c YF\f3\x93\x933\x8c\xa2\x12;\x84[IMI\xa4\xb9\xa9!\x98\xcc\x0f\x18\xb6+\xa8\x1b@\x96$\xa2\xa3\xa3\x8bB_لI\x9d\xb6,\xf6\f8<\x9c\xc6F\xbb\xc9d2]\x9dJ\a\x12{:\x1d)i\xe9\x18\xf4px\xfb\xa7\x84\x99L\xb8ݮ\x90\x9d:\u0095\xc5\xee\xda\x04\xc5\xe7\xf3a\xd0\xeb\xabzڌ\xda3\xe0\xe8D,\x16\xf3 \xbd^\f\xc9d^*Oۅ\x14Y\xcdV(\nH2\xa2\xc5\xcaCߟ\xcf\xf2w\xd7\xd3h?GLl\\pgA\xc77\x97\x9a\x9d\x85\x82զ\x95^/\xedN'\xa2^\u007f\xb2\xa7\xe2=\x03n\xae\x89;y\xba(+,̬\xf9\xcc+\xe4~h\x16TQ\x189z\x02\xb6\x18\x13z\xbd\x1eSt\xac\x96\xbe\x11B\\_o\ao`\x15S\xc4\xe5l\xe7\xf0\x91<\xd9l\xb6|\xd5\xd3W=\xba%\xb9\xae.\xcd\xef\x97\ff\xb3\xf9*s\xd5\x01\xbe\xaa\x92\x13\xcd\x16\x9e\xf9\xd5\xcf\xd1\xeb\x8dA\xa3Co\xfdn7\xb8\x058\xdfl\xc7d2\xd6$\xdd0\xc7\x01o\\\x19\xe0sM\r\t&\x93A\b\x0f\x8f\f\x8e\xb3\xden\x12\xbd\x04\xf0p[\x82\xc6\xd5\xc0\x12I/\xf8\x19*xY\xe9\xc4(\x87\xa3\rA\x10\x8a;\x8e\x16\\\t`Q\x14-a&\x13\xc6\xc0\xee\x19\xf9b˞\x97\x8d\xfc\xea\x18\a!a\xa9j\xd9\xcb\xcaʈ\x8a\x8c\xfc\xd4f\xba\xa3\xe7\xcfzzYZr6\xa2\xbe\xa1\t\x8b%\\\xe3\xe8\xb7(\x19\x10\xb0ꊌ\xe2r\xd1\xd4tN\x1e9\"w\xd3%?\xeb饢(q\xf9\x05\xedx\xdc..\xd8\xeap\xcd\xe82\xac~ \x1a\x135\xc5\xd4r\xd5\xedm-8\x1c\xed\x9e!\xe3\xa7\xd6_\x15\xe0Դ\xfeG=^hn\xb6\a\x1b\vp\xf6\x9a&\xa4.\x8747$\xcb\x1d\t\xf9\xba\xbaj\xc2\xcca\x85X\xcc͗\xfa\xfa\x02\xc0\xf6\xf27\xb1+{\xb1\x17\xfd\x11A\x10n\x8d\b\x87\xea\xaa*\xd0k\xa1\xa5(\xfe\xff\xcds\t\x04}\xb6 \xa0x\xbd47738+s\x15ї>\xfd\xd2is\xe9\xcbOeb4\x1aVy\xbd\xbe\xa4\xea\x9a\xfa\x01\x8evgrC\xa3\x9fV\a\xac\xfd\u05eb\xe8̖ X\xbf\x9f\xeb\x97\xe4\xea\x1aX_\x04\xb8$\xd1\xd6lgժUޙ3\xa6EN\xb8\xf5=ϥj\xee\xb0\xd2E{\u007f\xc1\x03?|\xa5\xc0\x1cƠ\xc5\v&1i\xc28\xfa\xa7\x0f\xa4\xb1\xbe\x8e;\xee\u007f\x8b\x93'\x8e1l\xfc\x14M\x95tW\uf8ae\x88\x0f!\x1bZ\x15\x01\x9f\xcbIޱ#\x88\xa2\xf8\xc6\xc0\xe1c=\xf0\xde%\xab\xe8\xd0Mkx\x04\xb7~73\xbf\xb6\x01\x86\r\xcb%{\xf4\x04DQOuM5)\x89\xf0ȓ\xabh\xad\xafQW\n$\xff7\x0f\xb6\x13\tH\xaev\xce\x14\xe4s\xe0\xe0\xd1ʅ\v\x17>iK\xfdQ\xef\xbe\fUi\xbb\xb2\x89\x87g\u07ba\xa8\xd5\xc1\x8a\xc9\xe3R\xd0\xe9t-\xa2\xa8\xdb7y\xd2\x04\xe9\x9d\xf7W\xcd\x1d\x98\x9e\xc2\xd2g\x9eS\xf7.\x87\uea3d\xd6\xd8;\fd\xc8Ja`\tF\x10\x90\xddn\x8a\xce\xe4\xf3ѺM%\xf3\xe6\xde8g\xc6\xc2\xd5gz[\xf5\x05\x1b\xc4\xedʗ\x1c[\xf9\xe71\x0e\x87\xc37\xf5\xee\xfb\x8e\x13\xd9\x17\x908\xb7\xef\xb3\u007f>\xfeī\x8b\xe7\xdd<\x8a\x05\x8f>\x0e\xad\xad\xd7\x0f0\xa8cT\xafW\xc1\xfa\xfc\x1dc\xd6\xdd\xde\xc6\xf1\xbc#\xec\u07b3\u007f\xab\xdb\xe3\xbd\xf9O\xff\xb8\xa4a\xee\x19\xf0\xc5\xc8^\xfe\xa6\xa9\xe8\xf8\xe1\xc3\xff\xfd\xec\xfb9\x0f\xdd7\x95\x9b\xef]\x02N\xcd?_k\xc0\xa1~^;:\x80χ\xa3\xed<\x1f\xaf_ϖ\xcf\xf3y\xfa\xa9\xc53\xa6ݹb\xe7\xe5V\xdd\xeb㴶\xb4G=vךY/\xc3\a\x0f>\xf6\xfeM\x16\xab\x85i\v\x16\xab\xdbu\xaf69\x10\xa0гL\x1d\x9b\xdf$\xf0\xfb9\xb8\u007f7\xfb\xf6\x1fb\xe6\x8c\xef\xe0r\xb9y\xf7\x83UOٕM;m\u00ad\x97\xc7\xcb\xcb)l3\xdfU\x9fy\xeb\x9d7\xbf\xb3l\xc9\xd3o\xbc\xbd\xcd_\xf8\xe5\x17\x10\x19\xd59\xe4\xbcjO\x15rN\t\xf09\xdb9zx/\xab\xd7l\xa4\xba\xa6\x81a\xf3\x17\xb0h\xf1\"\xfaDE\xccj\u07b7'\xfark\xbf\xec\b\xc2&\xcc%k\xdeC/\xbd\xf8\x9b\a\x1e{\xf6\x85\x0fX\xf1\xa7\xd7T)\x98\x8c!kB!+\a\x9d\xf2SZh\xaa\x84$\x13B\x9f\xa3\xfe\xf6\xb5;\xa8-/\xe6\xe4\xe1}l\xdb\xfa\t\xfb\xf6\x1f~q\xc9}\v\x92\xb6}\xe9{e\xdf\xdf\xdf\xc5:4\x17[l\xb4i㦍\x8bN|\xf1\xf8\xe5\xb1\xf3JO\x97ڕ\x9d\x94l\xfe\xe7\x945\x1f}\xfc\xba\xcb\xe3\x1d\xf5\xd0\x03\xf7\x91\x9c\x9e\xa5\x9eK\b̪$\u007f\xf7\xc7\a\x14EM\nv\x9c\x8e\x91q\xb7\xb5\xd0\xd6\xd6B}]-\xb5554ڛ\xaa\xd3\xd3\a\xacOO\xcfX\x17?q\xea\x8e\xc0Y\xc3;f\xb2\xf2w\xbfy➂ӧط\xff\xf0\xb6_\xfc\xf5\xad\x9b.5C\n\xa5+>\x12o\x13\xa6\x03\xec\xb1K\x1bFo|\xed\xa5'V\xac\xfa\xf7s\x89\tq\x91#G\x0e'k\xf0PP\xc0\xd4'F]\xadP\x14\xf0\xf9h\xae\xaf\xc1j\x8d\xc0\xe5j\xa7\xbc\xb4\x98\x9c\xdc\xd18\x9dm\x94\x16\x17QQQ\xe9։\xba2\x83\xc1p`Hv\xf6\xfb\xb3'O?\x899\xdc\x01F7\x04Of̛3y\xd1\xeb\xcb\xfe:%\xa6Od\xca\xc0\xf44\x0f\xc6\xcb\xd3\xeaks~X\xf9\fZ\xeb\xc3\xf26o\xb8\u007fæ\xadO\x9eknM7\x87\x99\xf4#r\a3(+\x13A\x10\x10\xf5z\xde~o\x15w\xddv\x13\xe3'N\xe1\a\x0f\xff\x9ai\x932\\\xb9\xb9C\xb7&$$lKNM?l\x18:숺\t4\x1cu\x0f\xb5\x0f\n\v k\xa2\xc6\xe4IT\x1e\xfb-\x1e\x8fkșӧr\xc6Ͻm\x03q\xf1^\x18\x8cM\x18\xf8\xcd\x01\xee\x04\xbem\x15\xf5G\x0e\xf4)**\xcc>t8o@m\xbd=\xfcL\xb1\xd7\x1aae\xa8_\xe2\xe6\xd9\xd32\x13\x1f|\xed-\xf6\xfc\xed5\xca\xca+\xfe~\xef\xb3\xcf\u007f?o\xedjF\xdc\xf3\x146a|/\x98{\x16\xeav`K\xfc\u007fWԿk\xfe_\x1e\xb4\xff\xd6p\x1eث]\x1d\xf4\xe5\xda{\xc7\xfd\xed\xbd\x15\xfb\xf0\x9cӍ\x197\x91\xbd\xfb\x0f'\xa2(\x8c\x982\x13\\\x15\xbd\xab\xbf\x97\x92\xbc\x18]\xd3y\x9e]9\x8bݱZ\x95B7\x94}\xfb\x92\x83I\x89\xf1\xebJ?߆$I\x9coq\xc4\"\b\xd0/\x11\xccIײ+\x17\xa5k*\xe1Kq\xdf&̢x\xff/_{\xe7\xdd\xf7\xe7[\xadf\xd1d2\xb4c\x8c\x80\xe6z\x88\x8e\xffF\x00\u007f\xe33\xf9\xa8\xf13\x0e.\xb8s\xfe\x9a\xe2\xd2*\x86\xe7\f~\x9fsu\xb8\xcaK\xb8\x96\xff\xba\xa2'\xfa?(\xae\x03\xd4\xca\xd2yE\x00\x00\x00%tEXtdate:create\x002021-09-29T13:27:06+00:005\x15\x1b\xcc\x00\x00\x00%tEXtdate:modify\x002021-09-29T13:27:06+00:00DH\xa3p\x00\x00\x00\x00IEND\xaeB`\x82")
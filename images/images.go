@@ -0,0 +1,10 @@
+// Package images holds the game's PNGs and the asset manifest, which also
+// names the sounds and fonts that come with ebiten.
+package images
+
+import "embed"
+
+// FS holds manifest.json and the PNGs it names.
+//
+//go:embed manifest.json *.png
+var FS embed.FS
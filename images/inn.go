@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Inn_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01\x00\x00\x00\x01\r\b\x06\x00\x00\x00\xe0컸\x00\x00\x00\x01sRGB\x00\xae\xce\x1c\xe9\x00\x00\x14zIDATx^흿\xee$\xc5\x11\xc7\u007f\x97\x1do`\"\x0e\bp\x06\xc1Iv\xe8' \xbc\x04\x89\f\x89\x17\xb0\xc4#X\xf2\v \x91!\x91\x10\xf2\x04\x0e\xb1D\x00\x99\x1d\xf8\xb8\x8b\xec70\xd9Ys\xb3\xd6\uebf7w\xfb\xdb5\xd5\xdd\xd53\x1f2\xeeW\xfdg\xbeU\xf5\xd9\xeaڙ\xd9'\x0f\xfc\xb7\x1b\x05>x\xf6\xfcM\x8f\x8by\xf9\xea\xa7'=\xd6a\x8d\xf6\n\xe0\xc8\xf6\x1aw[\x01\x00t\x93z7\v\x01\x80ݸ\xf2\xe1\x01\x00\xecș\x9d.\x05\x00t\x12\xba\xc72\x00\xa0\x87\xca\xfbZ\x03\x00\xecȟ\x00`G\xce\xect)\x00\xa0\x93\xd0[\x97Q\x92\xfb\x9b\xcf\xdfߺ\x8c4\xfe\x8bo\u007f-\xda\xd1(,J\x14\xc2\x00\x00\x84pCy\x13\x00\xa0\xac\x11\x16\xf5\n\x00\x80z͆\x8c\x00\x00Cd\xdf\xfd\xa2\x00`\x12\x17\x03\x80I\x1c5\xd96\x01@\x00\x87)\xc9\xfd\xd7\x17\xbf\xbf\xda響\xffǣ\u007f\x1b\xd9\x03H\xf7\x97\xee-'3}\x82\xf1\xc1\a\x00\xc6\xfb@\xfa\xfe\x1e\x00\x04p\xd4\x0e\xb7\x00\x00\x028\x95\n \x80\x13\x0e\xba\x05\x00\x10\xc0\xf1\x00 \x80\x13\x0e\xba\x05\x00\x10\xc0\xf1\x00 \x80\x13\x0e\xba\x05\x00\xd0\xc1\xf1\xa5\x04ϝ\xef\x95mEn\x02Z\xf6O\xa3PQ\xcd\xd7\x06\x00\xf8ꙝ\r\x00\xe4E曂\x0e\xc1WX\x02\x00t\xf0\x01\x00\x00\x00\x1d\xc2̴\x04\x000\xc9V7\b\x00\x00\x80\xba\x88\xe9g\r\x006j]J\xeeez\xeb\x19\xbf\xb4\xb5\xd9{\x00\xe9\xf5)G\x02\xfa\x04\xa5\xa8\xa8\xfb;\x00\xa8\xd3\xeb\xca\x1a\x00\xac\x92x@\x0e\x00l\fF\xc3p\x00`\x10\xedr\b\x00\x00\x00\x1bCh\xe8p\x00\xb0Q~\x00\x00\x006\x86\xd0\xd0\xe1\x00\xe0\x8e\xfc#\x93[\x89\x8a#\xf4\x00\x94\a\x9cJ/(ᡣ\xdb\xd1\x04\x00\x00\x80\u009aG6\xb9\x84k\xd5\x03\x00\x00\xd5\xee\xa9\x1a\x00\x00\x00@U\xc0,\xc6\x00\xa0Z\xb2\xb0\x03\x00\x00\x00\xa8\x0eN\x00P-Y\xd8\x01\x00\x00\x00T\a'\x00\xa8\x96,\xec\x80\xc3\x02 z\x83O\x89\x98\xbd5\x01\u007f\xfe\xe5\xf5\xd5e\xff\xe9\x0f\xbfS\xa4\xb8kSj\x12.\x83\x8f\xda(\x04\x00wBǣ\xb1\xb59z\xefL\x00\x004u\x01\x00\xdf\x02\\)@\x05\xa0%OΪ\xd5\x11\x80\n\xc0\xee\x13\xebH*\x00*\x80\xea\xd8\x01\x00Ւ\x85\x1d\xb0K\x00\xec\xe1\xd3]\x89\x18\x8e\x00\x8aJ\x9a\xcdQ\x8f\t\x00@\x8b\x8f\x90V\x00\xc0\xcf-\x00\xc0O\xcb\xe13Q\x01\xb4u\xc1lG\x00E\r\x00\xa0\xa84\x89\r\x00h\xeb(\x00\xd0Vߞ\xb3s\x04詶\xf3Z\x1c\x01\xfc\x04\xa5\x02\xf0Ӳ\xf9L\xa5O\xf8V\xdf\xdf羦J/\xf6\x93\x8f\xdfk~\xfd\xff_\x00\x00t\x93\xfa\xedB{\x84Ĕ\x15\x00\x00X\x03\x1f\x00\x00\x80\xad\n\x00\x80\n\x05\xa9\x00V\xb1\xf6\xd8\x03P\u0080\n@Q\xa9\x83\r\x15\x00\x15@\x870\xbbZ\x02\x00tP\xbd\x94\xdc\xcb\x16F\x9d\xf1{\x9e\xefS\xa9\x95\x17f*/\xcf\xf0p\xa1\x92\b\x16\x1f宱\xd75y\xe82c\x9f \xdc\x11\x00\x00\xe4C\x11\x00x\xa5h\xdby\x148Fz\xf2\x10\x00\\\xc4C\xe9\x8cO\x05p\xbb\a\x90\xa6\x15\x15\xc0m\xd0\x00\x80;\x10\xa6\x02\xa0\x02\xb8T\x80#@ۊ\xa5k\x0502\xb9\xdbʸmv\xa5\xbc\xcf}\xa2F\xfe\x1a0WM\xfd\xf8\xfa\xcd#\xa1\xbe\xfc\xf4٣\xff\xdfC\x0f@\x89\x84H\xc7\x04\x00\xa0x\xac\xb1\r\x00\xc8\u007f\xab\xb1\xfc\xebl\x15\x80\x12*\x00\xe0\x8eJ\x96\xb3\xa3\"zd\x1b\x00\x00\x00\xd2\xf8\xec\xd5'\xa0\x02\b@\x06\x00\x00\x00\x00\xc0I\x01*\x80<\x91\xe8\x01\x04 \xb5\xd3\x16vy\x04\xa0\xc1w;:J\x9f\xf0V\xe8En\x02*\xb9\xf2\xf5\x0f\xaf\x8af_\xbdx\xb7h\xb3G\x83\x12$\xbc\x8e\bnG\x00\x00\x00\x00j\x13\x11\x00\xdcV\f\x00\xd4FS`{*\x80\xbcs\x00\x00\x00\b\x9c\xb6~[\x03\x00\x00\xa06\x9aBU\x00Jy\x9f~_\xfb\xb7\xbf\xff\xe7\xea\x9aG\xdeJ[\xeb\x00\xd5>M\xee\x0f\x9f>-\x0eMo\x80)\x0e\xb8a\x10\xa9\a\xf0\xd9G\xef<ڥ\x97\xaf\xa9\x12\xf2\xce/\x01b\x19\xa5\xf4\t\xa4\x1e\x00\x00\xd0\xcf\xf7\x00`\xd5\n\x00X\xb1\xae\x8d\x03\x00\x9aNͭ\xa8\x00V\x89\xa9\x00\x9a\x87ڣ\x05\x00@_\xbdo\xae\x06\x00\x00\xc0\x88P\x04\x00#TϬ\t\x00\x00\xc0\x88Pt\x03\x80\xe5|\xaf\\\xf0\x1e\x9a\x80ir\xa7e\ue883\xf2\xf3\xd5\u007f\xf9\xfeߏ$\xdbc\x130\xbd\x99\xa9\xf4n\x05\xcf>A\xe9[\x96=>P\xa4\xe4\xa0\x02\x89'\x00@o\xf0\x01\x80U+奠\x00@IѶ6\x00`\xa3\xbeT\x00y\x01\x01\xc0\xc6\xc0\xea4\x1c\x00l\x14\x1a\x00\x00\x80\x8d!4t\xb8\x19\x00\x1eg\xa6\xe8=\x80ҹq\xf1\x9c\x87\x0e\xcb<G\xec\x01\f\x8d\xfcdq\xe58\xa2\xf4r\"]\x93u/)\x14\xb2=\x00\x8f\xc0\a\x00g\x17\x01\x00k\xb8\xfa\x8c\x03\x00g\x1d\x01\xc0I\v*\x00{r)=\x00\xfb\xec\xfe#\x01\x00\x00\xb8\x8a*\x00`O4\x00`\xd7n\xf4\xc8f\x15@Z\xf2\u007f\xf7\xcf\xff^]\xab\xf5\xc5\x17\xb5\xa2\xf5Lneo\x1c\x01\x14\x95\xc6\xda\x1c\xa5J\x00\x00\xa78\xf3\xe8s\xa8!\v\x00T\xa5\xc6\xd9\x01\x80\v\xed-\xc9A\x05p;x\x01\xc0\xb8\xc4VW\x06\x00\x00@\x8d\x95j;\x00P-Y\xf7\x01\x00\x00\x004\v:\x00\xd0LZ\xb7\x89\x01\xc0\x1d\x00\xe4\xbe\xd3O\x95Oo\xa4h\xd9).5\xf9,G\x18\xb7H\xcaL\x04\x00Z\xaa\xdbo\xee\x1c$\xd2fw\xb4\xd8K\xd515\x01\x01\xc0\xb6 \x03\x00\xdb\xf4\x8b2\x1a\x00\xdc\xf1\x04\x15\x00M\xc0(\x89\xdaj\x1f\x00\x00\x00\x98b\x8b\n\xc0$[\xb8A\x87\x05\x80\xc5\x13\xd6\x1e@\xe9|\xbf\xec%\xfa9+\xd5\xcb\x03\x00\x91tQ\x9e2\xebuӗ%6[\x8e\x89\xe4\xa7\xdcu\x9az\x00\x16\xc1\x00\xc0Y5\x00`\x89\xa09\xc7\x00\x80\x93\xdf\x00\x00\x00\x983\x85\xb7\xed\x1a\x00\x00\x80\xab\b\xa2\x02ؖT3\x8d\x06\x00\x00\x00\x00<<<\xd0\x03\xb8\x8d\xad\x91=\xac\xa1=\x00\x85\xe4#\xc5Q\xf6g\xb1IEO\x93\xc3\xfa\xa9\x91\xce\xdbK\xbb\xdc\xf1.]\xfb\xa8\x8dB\xe5\xe7\xda\x14mZ\xf9\x12\x00X2x\xe3\x18\x00\x90\x17p\x8fU\x02\x00\xb8s\x04P\xf2\xa8\x15\xf9\x94\xb5[\xd9\x00\x00\x00p\xa9\x00\x15\xc0\x9dL\x03\x00yqr\xbap\x04h\x85l\xfb\xbc\x87\xad\x00FR\xcd\xee\xae>#K\xdaX\xa1\x17\x19\x00\x8a\xb2%]\x969f;&(\x00\xf0\xd0\xc6+f\xdc\xde\n\xac8ӺiE\xb0\xc86%m\xac\xba\x00\x80x^\a\x00\a+\xef\x95\x10\x04\x00y\x95J\xbaP\x01\xf8\u007f\x95\xd8\xec[\x00ř\xd6O:%\xc9\"۔\xb4\xb1\xeaB\x05\x10\xcf뻬\x00\xd2;\xd9r\xb2\u007f\xf5\xe2\xddx\xde\xe8\xb0#˻\x12\xbc\xb65;\x00\x14\x1d\x14}?\xf9\xf8=e\xaa.6^\x00(m\xb6\xf4\xa1\xb2\x8cW\x1a\xc7R\x0f\x00\x00\xdcv\x87\x12\xa0\xad~v\n\x00\xac~\x01\x00\xf6o\x8e\x00@\t\xb5\x85\xbf\x03\x80U \xeb1\xa6$\xbf\xa2/\x00\x00\x00\xa58j\xf6w%@\xa9\x00\xec\xf2+\xfa\x02\x00\x00`\x8f\xb0\x8d#\x95\x00\x05\x00v\x91\x15}\x01\x803\x00>|\xfa\xf4ьGm\xf0\xa5g\xec\xcf>z\xe7J\xe9Vɝ.\x94K\x84Qo\xa4U\x1e\x06\xb2\xa7|\xfd\xc8RC\xac\xe7\xcdD_\xff\xf0*L\xee\x94tY6\x9a\xed\x01\x00\x80Շ\x00 \x9f\x8c\x00\xe06\xa4\x00@=\xc0Î\x00\x00\x00\xa068\x01@\xadb\x81\xed\x01\x00\x00\xa8\r\xcf]\x00\xc0\xe3+\x1d\xa5y\xd3\xeb\xfc\x9cs\xa2r>\xf2\xd0A\t \xabVG\xb8\x0f@ѯd\xa3\xe8\xeb\xd5H\x8c\x04\x00%\xee\xa5\xfb\x00J\x02\xe7\xfe\xae\x88\x0e\x00V\xe5\xacZ\x01\x00-2\x15}\x01\xc0\x85\x96\x1e\x9f|\x8a\xe8\x00\x00\x00h)\xbc\xcdJ\x89E\x00\x00\x00\xae\xa2\xcc\x03\x84J\xe8*\x01\x9a\x83%\x15\x80\xa2\xaeVa\x01\x00\x00\x00\x00\xb4|\xba\xfazt\x19\xd6\v\x96\xe2\x16\x1f\x99)\x80\x05\x00\xce\x00P\x1c\xa58\xc6rL\x88\xd4\xe0St\xb0\xdaP\x01h\xca\xe5\xe2,Mx\xe5\xcd\xcc\xca\rE#\x9b\x80J>\xa57\x8f5k\x02*\xaeQ6\f\x00n+\t\x00\x94(\xcb\x1f\x01\x00\xc0\xaa\x1d\x00\xd0b(\xa4\x15\x00\xd0\xdcB\x05p։\n\xe0\xa4E\xe43\xab\x16\xd6\u05f7*\xf7\xba\xa6h\xb7\x02\x97\xf4\x02\x00A\x01Prܭ\xbf\x97\xce\xf8\xbd\x12\xc1\xba\u007f\xafqT\x00\x9a\x92\n\x00\x94\x99\x94>A\xf4\xe7h\x9a\xbd\x13P\x11\xd0\xcb\x06\x00\xacJ\x02\x00-\xa2\x00\xc0Y'\x00\xa0\xc5\xcc\x14V\x00@s\x13\x00\x00\x00Z\xa4Lf\x05\x004\x87\x01\x00\x00\xa0E\xcadV\x00@s\x18\x00\x98\b\x00\xa5\xf3\xfdr)\xa5\x9fצ\t\xa8%\x86\xd5j\x8f\xdf\x02X\xb5Hǥ7\x02\xe5\xe6\x1d\xf9\x86\xad\xf0=\x00\x00\xa0\x87\"\x15\x80\xa6\x95W\x05\xa0\xac\x06\x00\x14\x95\xee\xd8\x00\x00]@\x00\xa0i\x05\x008\x02h\x912\x99\x15\x00\xd0\x1c\x06\x00\x82\x00\xc0\xf2鮸\xb8\xd7\xcf1){\xe9i\x13\t\x00\xe9\x1b\x93-\xcfp\xb4Ү'\x00\x94k\x18yL\x18\xda\x03\x00\x00Jx\xe86\x00@\xd3\n\x00P\x01h\x912\x99\x15\x00\xd0\x1c\x06\x00\x00\x80\x16)\x93Y\x01\x00\xcda\x00\xa0\x03\x00\x94g\xfb\xbd\u07ba\x92\xba\x9d\x1e\xc0\xaaH\xaf\xfb\x1ff\xbb\x0f \xf7\xeb\xd6_~\xfaL\xa3\xc7 \xab҃GV_7\xeb\x01\x00\x80\xfe\x91\x12\xa9\x02\xb0\x06d\x0f\xd5\x00\x00\x15@\x8f8\xeb\xbe\x06\x00\xd0$\a\x00\x00@\x8b\x94ɬ\x00\x80\xe60\x00\x00\x00\xb4H\x99\xcc\n\x00h\x0e\x03\x00\x1b\x010\xf2|\xaf\xb8\xf8\xe7_^?2\x8bt\x13\x8a\xb2\u007f\xabM\n\x80V7\xe3\xa4\xfeO\xdf+׳\x01i\xd1j\x0f\x00(=\x00\x97\xd3%ח15\x01\x01\x80%\xecڏ\x01\x00\x9a\xc6\x00\x80\n@\x8b\x94ɬ\x00\x80\xe60\x00\x00\x00\xb4H\x99\xcc\n\x00h\x0e\x03\x00\x95\x00Hϒ\xadn\xe0\xd1\xdcW\xb6\xa2\a\xb0j\x94\xbe\x91\xb6\xac\x9c\xcd\xe2_\xbf\xfdv5\xb0U\xff\xc1\xb6\xc3ǣ\xf6\b\x00E\x97\xd2\xcdD\xcb\x1c\xd9\x1f\x06\x01\x00\x8a\xbc\xe3m\xd2\n\x00\x00\xe4}\x02\x00n\xc7*\x00\x18\x9f\xc7\xe6\x1d\x00\x00M:\x00\x00\x00\xb4H\x99\xcc\n\x00h\x0e\x03\x00\x00@\x8b\x94ɬ\x00\x80\xe60\x00P\t\x00\xe5g\x905\xe9\xfbXY\x9a\x80ʽ\r\xbdn(R\xf6\x92\xbb\xf9\xa6\x8f\xba\xb6U\xd2>Rn\x96^\xfa\x1e\x15\x009\xcd\xd3\xc6`\xb6\a\x00\x00V\xe9z\x05(\x00\xb0AF\x1d\x05\x00\xceJ\x01\x80\x93\x16J\xd2\x01\x005Ů\xed\xa8\x00\xec\xda-#\xd3D\xf5\xfaP\x06\x00\x00`[d\x8a\xa3\x01\x80(\xd4\r3\x00P\xa1_\xda\x03P\x86\xf6\xfat_\xf6R\xaa6\x94\xf3\xbd\xe5;\xfe\xdc\r;\xca<\xe98eL\xaayn\xedԦ\x17$\xa2\x1f\x01r\xf1\x9b\xc6\x04\x15\xc0\x9d\xac\x06\x00yq\x00\xc0\xaa\v\x008X\x0f\x80\n`U\x00\x00\x00\x804\x17\x0e\xd1\x03\x00\x00\x00\xe02\x06\xa8\x00\x0eV\x01D:\xdf/җ\xce\xf8\xb93v\xabs\xb8r\x9e\xef\xb5v\xaf>A\xee-\xc6^g\xea҇\x8dr<\xcd=lG\x13\xb0\xa4\xec\xc5\xdf-7\x02UL\u007f״\xd4\xe0\x03\x00g\xf9R\xf8\x00\x80U\x1b\x00\xb01\x1b\x01\xc0\x1cM@\x00\x90\xf7\x13\x00\x00\x00W\n\xf4*\xc3s\xd2\xf7Z\x9b\n\x80\n`c\xea\xafé\x00\xa8\x00\xee\x05\x12=\x80\x9d7\x01[\xfd4\x98\xc7\xf9~\x91^i\xbc\xa5\x01\xdc\xebSxd\x05\xa0\xd0ߣJ\xc85a{5\x01\x95k\xcc\xd9\xd0\x04\xacP\x0e\x00\xccY\x01(.\x06\x00\xabJ^\xc0\xda\xe5}\x00\x00\x00\x00܃\t\x15\x00G\x00\xe5\xc3\xe6ʆ#\xc0*\x89\xc7\x11\xc6s\x9e\xd4Q\xa5g\n\x00\x00\x00\b\x95\xdc\n\x8d\xe8\x01(*\xad6\xca1\xa1\x04\x8dho\xbe\xa6\a\xa0\xfb\xff\xea\xd9i姪G~\xba+\x97\x06\x00\x14\x95\x00\x80\xae\xd2jI\x0f\xe0\xa4\x18\x00\xb8\x1d:#\xe1S\x1b\xd0T\x00u\x8a\x01\x00\x00P\x8c\x18\x00P\x94\xa8\xb9\x01G\x80\n\x89-\xdf\x02P\x01P\x01\\*@\x0f\xe0B\r\xaf\xef\x1c+rx\x93i\n\x80RWxY\xac\xf4\x84ަ\r1x:\x05\x94\x98\xe9\t\t*\x80\x8a\x10\x02\x00\x15ba\x9aU\x00\x00P\x01\x90\x1a\aV\x00\x00\x00\x80\x03\x87?\x97\x0e\x00*\x01Pz\xd3I\xcb\xf3R\xba\xb6\xe5<o\xb9\xbb\x8d4ٯ\x02\xca\xcdD=!\x11\xbe\a\x00\x00\xf6\x9b\fG\xbc2\x00@\x05pĸ\xe7\x9aO\n\x00\x00\x00@2\x1cX\x01\x00p\xe1\xfc\x9eg\x9d4\xe6JG\v\xe5;|\xce\xf7\xab\xaa\u007f|\xef\x89KJ\xff\xf8\xfa\x8d\xcb<\xb3O\xd2\x13\x12C{\x00\x00`\xf6P\x05\x00-<\b\x00.Tm\xd5\xe5\xa7\x02\xf0\v]*\x00?-\x97\x99\x00\x00\x00\xf0\x8d\xa8Ƴ\x01\x00_\x81\x01\x00\x00\xf0\x8d\xa8Ƴ\x01\x00_\x81\x0f\x03\x80V\x0f\x03y\x94\xf7\x8bKi\xf2\xf9\x9e\xf1=҄F᪢\x17$v\xf9\xf3\xe0\x00\xc0#\xd5\xcesx}\xc2{\xec\n\x00\x00\x80b\x1c\x01\x80\xa2DU\x06\x00\xa0J\xae.\xc6T\x00wd\x06\x00\xbe1\b\x00|\xf5\xf4\x98\xed\xb0\x00\xf0J\xee\xd4\tG=\xefGJn\x8f\xc4X\xe68\xe21A\x01\x82\xa2ozo\x8e\xf5k\xf8f\xef\x04\x04\x00\x8a\x1bu\x1b\x00\xa0k\x15\xd9\x12\x00\\x\x87\xc7v\xf5P\x05\x00\xbaV\x91-\x01\x00\x000\xc5'\x000\xc9\x16n\x10\x00\x00\x00\xa6\xa0\x04\x00&\xd9\xc2\r\xda\x05\x00\xbc\xce\xf7JC\xcfK\xb0p\x91\xc0\x86PऀG\x1eX\x1f\xd835\x01\x01\x00\xb1\x8b\x02~\n\x00\x80;ZR\x01\xf8\x05\x1a3\xc5T\x00\x00\x00\x80\x98\x91ɮ\xba(\x10\x1e\x00\xe9\xf9B\xf9\xfaN\xb9(E]\x8fߥS\xd6\xc1\x06\x05z(\x90\xabh=rE\xa9\x94s}\x824\x97\x9f|\xf0\xec\xf9\xd5\xfb\x9e\x00@\x8f\xd0`\x8d#(\x00\x00*\xbdL\x05P)\x18\xe6\xa1\x15\x00\x00\x95\xee\x01\x00\x95\x82a\x1eZ\x81)\x01\xa0(\xeaq\x8eɭ\x13\x19\x00\xd1o\xceI\x1f\xb6\x19\xb9\xdf܃?ʹU\x89\xbd^6\x1e1\xde\n\x00J\xee(:e{\x00\xca@\x0fq\x94\x8bh\xb5\x8er\x8d\xa9\xcdȄR\xf6\v\x00\x14\x95t\x1b\x8f\xd8\x03\x00\xba\xdeo-\xa9\x00*\x05\xbb0\a\x00v\xedr#\x01\xc0\x1d==ġ\x02\xf0\rX\x00૧G\x8cS\x01T\xfa\x84\n\xa0R0*\x00\xbb`\x85\x91\x87\x00\x80\xa2^\xee^\x01\x0fqFV\x00\xb35\xa4\x94\x87?FV\x00\xe9\xda9}[\xbdmZ\x89a\x8bM\xfa\xe0\x8c%\xe6GV\x00/_\xfdT\xfcm\xb8\xa2\xc1\"\x1c\x00\xb0\x84\x8f\xef\x18\x00૧2\x1b\x008\xa9\x04\x00\x94pik\x03\x00\xdaꛛ\x1d\x00\x00\x80\xfeQwcE\x00\xd0\xdf\x15\x00`G\x00H\xcfb\xb3\x9fG\x17פP\x18\xf9\xd6]\x8b\xbei\x82\xf5O\xf1\xf3\x8aJ<\xe4\xf6[\xea\v\xd0\x03\xa8\xf4j\xabo\x01,\x01Z\xb9\xf5\xa6\xe6\xb9\xe0\x03\x00~\x92\x03\x80;Z\xee\xa1\a\x00\x00\xfc\x92E\xf9\xf6ƚPmwy{v\xeb~\xa9\x00\x9c=F\x05\x90\x17\x94\n\xc09В\xe9\x00\x00\x15@\xdb\b\xdb8;\x00\xd8(`a8\x00\x98\x18\x00\xcaC:\xca\xcf-GnJY\x00\xa0\xe8bI+\xe5I\xbf\\BE\xd2W\xb9\xee\xf4\x1a\x94&`\xaay\xeemZ\xadz7i\xf5|\x98\x1b\x81\x94@\a\x00J\xc8k6\x00\xe0\xacS\xda\x03\x00\x00Z\fݴ\xb2\xf4\x00\x00\xc0*g\xaf[\x81\x01\x00\x00x(u?\xad\x1c\x00\x00\xabrJ\xf9Y*%\x150Z\xfc\x04\x00\x00\xc0P\x00X\x02\x9b#\x80%\xd5\xf3c\x00\x80/\x00\x14\xcf(7y\x1d\xa6\a\x00\x00\u0590\xa1\x02PR\xc7n\xa3Ta\x1e=\x00e\x87\x00\xe0B%\x00\x00\x00\x94\xa4\xd9j\x03\x00N\nF\xbb\x13\x10\x00\x00\x80\xadɭ\x8c\a\x00\xce\x00P\x127=\xab皍\xca<\xa9\x83\xd3y\xbf\xf9\xfc\xfd\xab\x18\xf8\xe2\xdb_\x95\xb8\xe8b\x93\xee/\xb7\xb7\xc8G\x80\xe8\xfa*NT|\xd0\xeb\b\x90\xeeW\xe9Ä\xbb\x0f@I\\\x00\xb0\xbaZ\t>\x00\xa0\xa4\xb1\xddF\xf1\x01\x00\xa8\xd0\x17\x00\xe8b)\xc1\a\x00t=-\x96\x8a\x0f\x00@\x85\xb2\x00@\x17K\t>\x00\xa0\xebi\xb1T|\x00\x00*\x94\x05\x00\xbaXJ\xf0\x01\x00]O\x8b\xa5\xe2\x03\x00pRVIn\xc5\tJ\x0f@\x99'\xb5Io\x92\x88ޤ\xb2\x04\x9fE\x17\xaf1\xb3\xe9\xab\\\xb7\x87\x0fro\x04R^\xef\xa6\xec\xaf\xd4\xe8\xee\xda\x04\x04\x00\x16\x97\xdd\x1e\xe3\x11|\xbe;\xba?\x1b\x00\xc8\xeb\x03\x00*\xa3\x90\n@\xff\x16\xa0\xd5\xf3\x18\x95.{k\x0e\x00\x00\x80%n\xae\xc6\x00\x00\x00\xe0\x12H\x0e\x93xTa\xbb\xad\x00z\x9dc\xb8\x11h\x8dd\xe5F \x87\x98\x97\xa6PnB\x89\xdecQ.\xd4\x02\x00\xcb\xfb\x00\x94\xbd(6\xe9\x87g\xd3\x1e\x00\x00P\\b\xb7Q\x82\xaf\x95\x0fJ\xbb\x06\x00g\x85F}\v\x90\xf3\x11\x00\xb8\x13\xb9G\xb8\x15\xb8\x94\xb8^\u007f\a\x00\x00\xe0\xeaQT\xaf\xe0Rz\x00\x96o\x1c\x00\x80\x97\x87\xae\xdf<D\x13\xf0\xac-G\x80\x8dq\x06\x00\xf4& G\x80\x8d\xc1V\x18\xae\x1c\xc38\x028\xfb\x00\x00\x00\x00\xe7\x902O\a\x00N\xd2\xe5\xde\a\xd0\xea\xd3\a\x00\x00\x00s\xc6:\x0f\x04\x00\x00\xc09\xa4\xf4\xe9\x94\xe0k\x05\xe1\xd2.i\x02\xd2\x04\xa4\tXʒ\x8d\u007f\a\x00\x1b\x05t\x18\xae\xf8\x80\x1e\x80\x83ЗS\x8c<\x028_\x8a\xebt\xdc\b\xe4*\xa74\x19\x008\xd8\x11@\x8a\x8aAF\x00\xa0\xbf\xf0\x00\x00\x00\xf4\x8f\xba\x1b+\x02\x80\xfe\xae\x00\x00\x00\xa0\u007f\xd4\x01\x800\x9a\x03\x80\xa0\x00\xf0\x88\x90\xdcSZ\x1e\xf3\xb6\x9a#ң\xbf\xb9k\xe4q\xe0U\x15\xee\x04ܘ\x01J\x13p\xe3\x12o\x87\x03\x00\x0f\x15\xcfs\x00\x00\x00\xe0\x12Q\x00 /#\x15\x80KxUM\xc2\x11\x80#@U\xc0\xb44\x06\x00-\xd5\xcd\xcf\r\x00\x00@\xff\xa8\xbb\xb1\"\x00\xe8\xef\n\x00\x10\x14\x00\x96ǁ-\xe1\x93\xde\xf2\xdak]\xcb^{\x8e\xe1V\xe0\xb3\xda\xdc\t\xe8\x1cyJ\x0f\xa0W\"\x02\x80\xbcs\x01\x00\x00\x98\xeeY\x00\v\xa7\x00\x00\x00\xb8T w3\x16\x15\x80%\xb3\ue321\x02p\x16\xb4\xc1tT\x00T\x00T\x00\r\x12k\x96)\x01\x00\x00\x00\x00\xb3dk\x83}\x02\x00\x00\x00\x00\x1a$\xd6,S\x02\x00\x00\x00\x00f\xc9\xd6\x06\xfb\x04\x00\x00\x00\x004H\xacY\xa6\x04\x00\x00\x00\x00̒\xad\r\xf6\t\x00\x00\x00\x00h\x90X\xb3L\t\x00\x00\x00\x00\x98%[\x1b\xec\x13\x00\x00\x00\x00\xd0 \xb1f\x99\x12\x00\x00\x80\xa1\x00\x98%Q\x8e\xb4O^\b\x92\xf7v\xee\xc53\xad~\xcf\xe10\xbf\x0e|\xa4Ě\xe5Z\x01\x00\x00p\x89U\xe5Y\x00\x97\x85\x98\xc4U\x01\x00\x00\x00\\\x02\n\x00\xb8\xc8\xd8}\x12\x00\x00\x00\\\x82N\x01@\xaf\xf7\x01\xb8\\\xd0\x0e'\xa1\tH\x13ph\x13\x10\x00\x8c\xa5\n\x00\x00\x00\x00`l\x0e\x0e]\x1d\x00\x00\x00\x0004\x05\xc7.\x0e\x00\x00\x00\x00\x18\x9b\x83CW\a\x00\x00\x00\x00\fM\xc1\xb1\x8b\x03\x00\x00\x00\x00\xc6\xe6\xe0\xd0\xd5\x01\x00\x00\x00\x00CSp\xec\xe2\x00\x00\x00\x00\x80\xb198tu\x00\x00\x00\xba\x01`h\xa4\xb3\xb8Y\x81\xf4g\xb5\x96\x89\xd2\xf7\xea\xbf|\xf5\xd3\x13\xf3\x02\xce\x03?x\xf6\xfcM:\xa5\xf2\xd3`\x96m\xf00\xd0\x1d\xd5\xd2;\x01-\x023f\xbc\x02\x00\xe0\xb6\x0f\x00\x00\x00\x18\x9f\xa1\x8dw\x00\x00\x00\x80)Ĩ\x00L\xb2\x85\x1b\x04\x00\x00\x80)(\x01\x80I\xb6p\x83\x00\xc0\x8e\x00\x90\xbb\x94\\\xd3\xc4#\n#5\x86<\xae\xe7(sX\xe2!\x92\xaf-\xfb_|k\xb9\x06\xebZ\xa5X\xb2\xec\xc5܅\x8dt\x11%a\xf8{{\x05,\xf1`\t\xd8VWb\xd9?\x00h\xe0\x8dHA\xd1\xe0\xf2v;\xa5%\x81\"\xf9ڲ\u007f\x00\xd0 \x9c#\x05E\x83\xcb\xdb픖\x04\x8a\xe4k\xcb\xfe\xf7\x00\x80\xff\x01\xc1\xf5\xcc\xca|\x8b.\xa5\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Player_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00<\x00\x00\x00K\b\x06\x00\x00\x00,\xdb\xe4\v\x00\x00\x00\x01sRGB\x00\xae\xce\x1c\xe9\x00\x00\x00\tpHYs\x00\x00.#\x00\x00.#\x01x\xa5?v\x00\x00\x055iTXtXML:com.adobe.xmp\x00\x00\x00\x00\x00<x:xmpmeta xmlns:x=\"adobe:ns:meta/\" x:xmptk=\"XMP Core 5.4.0\">\n   <rdf:RDF xmlns:rdf=\"http://www.w3.org/1999/02/22-rdf-syntax-ns#\">\n      <rdf:Description rdf:about=\"\"\n            xmlns:tiff=\"http://ns.adobe.com/tiff/1.0/\"\n            xmlns:dc=\"http://purl.org/dc/elements/1.1/\"\n            xmlns:exif=\"http://ns.adobe.com/exif/1.0/\"\n            xmlns:xmp=\"http://ns.adobe.com/xap/1.0/\">\n         <tiff:ResolutionUnit>2</tiff:ResolutionUnit>\n         <tiff:Compression>5</tiff:Compression>\n         <tiff:XResolution>300</tiff:XResolution>\n         <tiff:Orientation>1</tiff:Orientation>\n         <tiff:YResolution>300</tiff:YResolution>\n         <dc:title>\n            <rdf:Alt>\n               <rdf:li xml:lang=\"x-default\">right</rdf:li>\n            </rdf:Alt>\n         </dc:title>\n         <dc:creator>\n            <rdf:Seq>\n               <rdf:li>Simon</rdf:li>\n            </rdf:Seq>\n         </dc:creator>\n         <dc:subject>\n            <rdf:Seq/>\n         </dc:subject>\n         <exif:PixelXDimension>60</exif:PixelXDimension>\n         <exif:ColorSpace>1</exif:ColorSpace>\n         <exif:PixelYDimension>75</exif:PixelYDimension>\n         <xmp:ModifyDate>2018:04:07 17:04:15</xmp:ModifyDate>\n         <xmp:CreatorTool>Pixelmator 3.7</xmp:CreatorTool>\n      </rdf:Description>\n   </rdf:RDF>\n</x:xmpmeta>\n\xb8-\xa5\x8e\x00\x00\x1d\xc7IDATx\x01\xbd|\t|T\xe5\xb9\xf7\xff\xcc>\x99d\xb2\x0f\xd9C\x16\xc2\x12\x16Y\x84F\x10\x10Q[\x05Z\xabU\xb4\"\x97V\xeb\xad\xcbm\u007f\xad\xd7~\xd7\xef~\xb7\xd0\xe5\U000f6db6_\xebm\xef\xb5Z\xab\xb5Z#\xd6\n\x02\xa2\"!\xaca\xdf\t\x10 @\xf6e\xb2M2\xfb9\xdf\xff9Ä\x04\x92\x10\x10\xfb\xea\xe4\x9c9\xe7]\x9e\xff\xfb\xac\xef\U000fe0c2ϱ,\xd74C\xfc\xfe\xea\x9cs\xfb\xb6\xbf\xa3\x1a\f\xf6\x1bo\xb9\xff\xbe\xa0\x13u\x19\t\b\x86\x01cc5\x02F;\x8c\xb6\xee6S\xb7!ѿ,O\xf1}\x8e\xe4\xe8]\x1b?\xcf\x01\xbe\xf9\xc8w\xe7\x94\xff\xf9\x8foV\xbb=\x93\x8eV\xecr\x85[j\x12F\xdfx\xe3v\xb7\x0f\x01o+L=\x80I\xf1@k\xb6ۃ\x8f\xe7~\xfe`\x05\xab\xf2y\x01^]\xa7\xc5\xec*\xff\xe0\xf5}[\xb6\xdc\x13,\xb9\x03-;7\xa3e\xfdJ\x8c\x9a>\xa3\xc6\xe3n\rwvv\x9a4U\r)\x9a\x16\xb4\xa7\xba|#rr\xf6'\xe5\x8cj\xcc\xcc+\xd85\xe6\xd6[w\xfbU\xb4<\x96\xa4t\\o\xfa\xae;\xe0RM\xb3\x18\xbdp\xb5TW/\xdc\xfd\xce\xca\xe5[ז\xba\x10\x9b\n#\fP\xec1\b\x19\xac\x88Mς=;\x17\x96\xc4D\x04\xbb{`\xb0Y`\f\x87\x10h8\x0f\xff\xb9\xb3\xd0z\xdc\xf5\x85\x93g\x94\xdf|\xff\xd2\xff\x974Ƶ\xe7>E\t\\/\xe0\xd7\rp\xe9\x11\xcdb\xc9A\xe1\xe9\x03Gg\x9f\xd8Q~\x8f\xfb\xfc\xe9\x99͍\xed\xf6\xe4Q\xf9p\x16O\x821-\x1fA\x8b\x13=\xe1\x00Ը8\x18L&\x98\x8cV\x184\x13\xb1hP\xc3\x1a\x145\x04Sw\aԆ\x1a\xb4\xefی\xae\xaa\xc3\r\x13\xe7\xdc\xf2\x9f%\xf7?\xf4~g\x02j\x1fS\x94\xe0g\x05~]\x00\xbfڤ\xa55\xec+_txG\xc5#\xad\xf5\xf5S\xe2\v\xc6\x1a\x13&̀%k$\x02\x8a\x15\x01M\xe1\xd5\fM\r\"l4A1*0\xc8\xc8a\x15\xaaܫ\x1a4y\x10\xe2w\x82W\x14\x03b\xc9\xf1p\xe5^T\u007fP\xaa\xc69\rۧ~偟je\xd3\xd6/_\xae\xa8\x9f\x05\xf4\x90\x80\xbf\xa6i\xc6۪\xeb\xe7\xd7\xec;\x98\xe1\xed\xe8\xac\x1f=\u007fn\xd5#ٮ\xaa\xe8\x80\u007fi\xd7\x12\x8fn\\\xff\xf5\xaa}\xfb\x9f\xf4\x06|\xa3\x13Ɨ \xb6`\f\fI.t\x9b\"@5\x82\x11\x0ej\x04-w2\xa0\xa2\f>\xac\xa6Ej\xe9\xdcVB\xb0vv\xa0n\xd5+\xe89y\xecĲ\xff\xf8\xf5\xc3\x0f\x17\xa7TDǿ\x96\xeb\xa0#\x97\x9e\xd4R\xb7~\xf4ƿ\x9d\xa9([fM\xcfI\xf047\xc0\xae\x18w>\xf8\xe3\xdf>\xa1\xda\x11\xaa\xdeY\xf1\xc5\xe3{\xf6.\xee\xe8\xe9\x99\x14?q*LEӠ:c\xe1%g8O\xc4Ȯ/0C\xf0\xa9\x9aaX\xf4\t`i\xaa\x1a\b\\\rè\x1a\x11\xe3\xeb@\xe3ڷ\x11<y\xa8u\xea\xa2\xc5O\xdf0\xed\xe6\xb7\xef\xcbV\xbc\xc3\xea\xf0\x92J\x03\x02\xfe\x9fݚ\xf9\xe4\xf1U/\x9fع\xe5a眯@\xcd\x1c\x05\x03\x8dKs\xe9\u007faTa\xdez\x92\x92p\xa6\xf2\xc0\x8c\xf8is\x10?g\x11\xfcv\a\xfc!\xd1C\x05&\x11J\x8ag\x98\xfc4\x90r}\x00\xfe\x11\x0e_,\x14ۨ\x83\x10\x86F\x8b^O\xde\xf1!gI\u007f\xc5\tPU\x15qa\x1f\xda\xca\xd7\xe2\xfc\x9aw\xd4\xd9\x0f<T^|˼\u007f?\xb9uSu\x97\xd7]\xe49\xeb\xf5e\x8e+\xea̺\xe7\x963\xd4sz\xbb\xc1K_*zk}@Q}\xed\x87\xcf\xec\xf1\x17\x8cϳN\xb9\r=\xdd-\xb0\xa7f\xc0\u007fl\x1fz\xd6\xfc\x15\xae\xc2\x02\xc4L\xbf\x03\xca\xe8I\xe8\x11\xceiT+e\xf8.]\xe1\xa4D\x8a\xdcEH _u\x90\U0009cb8a6=2\x13f~\xa7\xcc\xe8\xfa\x1doPѴ\xe1}\xd4}\xb4\x12Yyc\xdb\xdbj\xcfh\x96\x18{\x82ɢ\xa8Y\x85\x85\xfeֆ\xe6\x03\xd0bJ\x17\xfe\xe8\x87o<\xe8TZ\"c\xf4\xff+&\xf2\xb2b3\xc0\xd0\xe9\xee2\xb5Ǻ\x91sb\x1f\x0e\xbd\xf2\x02\xc6<\xf5c$\xe4OB[\xf2\x16 o\x02\x82\xe3\xa6 \x14T\xe9f\xc2\xe4\xea\xf0\xc1F\a\xd3E\x97_h\x9f\xf8!7/H\x80n̄\xc7\f\xc5D\xf7\r\xe4t\f\xe1\xf7X\x80VՀ\xe4\xf9w\xa3\xdb݈\x9do\xbf\x94\xf0\x9d\x9f\xfc\f\xc5c'\xc0\x16c3\xaa\xbepLs\x9b\xbbd\xfb\xb6\xf2\x92\x95\xcf\xfe۽\xbfX\xbf\xfb\x91\xa7\xef\x98V\x19\x1d/z\x1dP\xb1\x8cq\xe80B9ٺg\a4\xab\x01Y\xf9\xd9h,[\v\xd5jF\xdc\xec\x058[}\x96\x06U%1*\x8c\x17\x8cL\xb4\xc3\xfeWrF\u007fO1\xa5\x14\b\xe7Lt?:7\x83Ah\x9dn\x84k\xce p\xf4 \f\x95\x87\x11ڿ\a\xc1C\xfb\x11:w\x8a\x90C0\x9a\f\xf0\xeb2\xa0\x81\x0e\fbڻ8\x80\xeb\x8e\aQ\xf8\xc5\xfb\xd1t\xa6\x1ai\xa9\xa9Hu\xc6#95\t\x13Ǝ\xc6\xe2%K0v|\xf1̏\xdez\xe5\xe5\xd2\xfa\xae\xd4(=\xa5\xe7\xcf\xdbEU\ad\xcdk+V\xa8\x93\uee2d\xb8\xe1\xc0\x8e\x99\x81\x84\fdΦ\x9ez\xdb\xe0\xcc\xc8Fʨb\xa4\x8c\xbf\x01!\x12\xaf\xd1\xd5(Cq\x97u\xc4`ɬ\x8af\x06\tB\xed遵ˍ\xee\xfd\xe5\xe8\xa9(\x83\x9d\x01\xb5+\xe4F\x96҅<{\x18~\xba\xa2p\xd5!x\x8f\xee\a(A\xf6\xb4l\x88R\x06\xd8\x03\x99\xc0;\x03\xc2\x06\x13\x9233Q\xbd}\x03Rbc\x90VP\xc0\xc9dp\xce\xf7f\xfeM\xcd\xcc@[cCΚ\x97_\x8c\xbb\xf5\x9f\xfe9\xedۿ\xfc}{|b\x8eOq\xc29 `\x99\x95/\xdcswؠږ\x9c\xdbY\xa6\xa4\xcc\xfd*\xe2\xa7ތ\x18g2\xecV#g\xddH\xffI\x18\xfd\f\x91\xb4\xba\xa4\x90\n\xc6W\xba4\x04\xbd\xdd0\xb8\x1b`8~\x00\xf6\xaa\x03(\xb0\x1b1\xfb\v\xd3Q2}:fL\x9e\x8a\xd1\x05E(\xc8\xcaE\xd1\xd8b\x14\x8e\x1f\x87\x11\xa9\x89\xa8\xdd\xfe):kO!u\xecDZ\u007f\x19N&\x90\xe2N\xcekv'\\\xf1q8^\xb6\x1e\xae\xfcQ\xb0&8i\xd1\xc5\x14j\x88\xb1\xd8\xe0\xa3\xd9\\\xf9\x87?Lۻ\xe9\xc3E\xb5UGn\x1f7e\xeaF[Rrˠ\x80\x13S\v\x8b:\xdd-KF=\xfe\xef\x8a#\xaf\x10\xb1\U0004930e\f\f\"\f\xe4\x14\v\t\x18ʟF\xa1\a\xb4\x00\x02\xee\x0e\xf8\xce\x1d\x85\xb6o\x1bf$80o\xdeL̾i\x06\xc6f&#/!\x06.\x9b\x11)6\x13\x12\xed&\xa49\xacHf\xa8\xe9\xa0\xe5\xcf\xc8-BÁ\n\x84\x02\x01\xc4\xe6\x17 \xc8\x003D\xc0\x11nr*c\x1dh?}\x06\xa7\xf6V`\xfc\xccY\xb0\xd1\xe7\x9b\x19ȘI\xa7\xdd\x11\v\x9f\xaf\a\xfb\xb7\x94\xc3\xdbА\xaa9\x1c\xa1\xc2\x19s\x8e\r\b\xf8G\xab\xb6\x14\xb7\x9e>\xf5\x9ac\xc6\xf4\x94ع\x8b8\xa3V]\u007f\xc2\xe4hXxF\xf1\x11Q\x8d\xfc\x89B\xeb\u007f\xd5_\x8bq\xf5t½{\x1b,'\xf7\xe3\u07bb\xef\u009dsK\x90\x9f\xe8D\x02G\x8e#a&\x83A'\xd2D\xfd4\xb1S#?V\xb3\x01N\x87\r\x0e\x87\x03\xf1\xae\f\xecz\xf7\xaf\xf4\x12\x99\xb0$\x8f\x80\x8f\xa0\xa4\x9e\xb8=\x1f'?.ɉ\x03\x1f\xbc\xc7\xc9\xcbGff*\u008c\xdeB\xf4\xdfVFt)\x19\xe9ؿ\u007f\x1f\x9a\xcf\xd6!wҴ\r\xc5S\xe7m\xbe\f0\xad\xa7\xf2\xf6\xa6\xad?o\xa8\xaf\xbe5\xf9\xee\xa5P,\xf1bF\xd9}\xc4QD\xc0\x8a\xe8\x10\x92\xa0\x1a\xaa\xf8}\xf0U\x1fC\xf0\xe06<\xfa\xd0\xd71\xb78\x0fI\f\x87\xedlg&Ѻ\x84\xf0*\x1d\x89Ֆ[\xfd\xc3\xef\xbau\xe6\x84Xmvt1\xda\xda\xff\xc9Z\xa4\x95́\x8f\xb4\x10\x13\x8c\fL\f\fp\xbc&\xb3\x1e\x87\x9f\xe6Jl\xf2\xac\xd9\xf0\xfb\xbc\xb4\xf04r1vX\xec\x16465A1ŝ\xbe\xf7\xc9\u007f}ޙ\x9d\xd8p\x99\x95\xfe\xc5\xc1S\xa9G*\xca\xef\f\xa5\xe6BIL\xa7\b\t=\xf2G.\x11\x8aDu\aU_\xbd*\x89\xa7U\xf6{\xba\xd0\\\xb1\t\vg߄9\xe3r\xe0\xe4l\x19ip4\x8a\x1d=\f\xa8rz\x11\xb0b\xcdŝsf\xf5q\xe4\x95\x041)1&L))\x81-܍\x9a\xf2\x8ft\xb5\x92\x896R\xa5\xc4GK-\x89\xdbO\xb6\xb6\xa1|\xc3:\xf4t\xb4\xb3\xab\x10\xab\x84a\xb79\x90/F6?\xab\"vt\xfe\xf9P\xb7\x87\xf2tI\xa9,\xdb1\xa1\xa7ɝj\x9bRBkH\x87\xcc\xceep\xf9\xe8\xa5\xf7&\xfa`\xa0+\x01Q\xacBՕ\x88\xf7\xd4\xe1K3\xa7#\x8e\x1c\x11Q\x14#\x16\xb1ۗtDΩ\x14\xd70\xb9\xd3\xde\xde\x01Og'L\\Q\xd9HCNj\n&N\x9e\x86\x8e\xaaJ(aZ\x10N\x9c@\nr1b$0\xa3\x91\x82\xea\x88ǟ~\xfd\x1c^|\xfe\xe7X\xf3\u05f7\x11\bx9qF$\xa5$\xcbpن\x1e\xa8!C\xec倽\x1e\xf7m\x06\xeaXLN\x11݂\xc4\xc5\x03\x01\x1a\xfcY\xb4\xbe\xac\x19:OWbʨ<d'őƈ\xb1\x19t\xadÆ\x1eO7V\xaf\xfe\x00\x8f~\xe3\x11<p\xdfb\xac_\xb3\x0e\x06\x83\x11\t\xb4蓦̀\x911uwK=\xe9'LN`8\x1cF8\xa0\xa2a\xc7\x06x\x8e\xecFan12\\\xc9(_\xbb\x16e\x1f~\xa8KJ,\x8d\x97\xcd\x11\x9f\xc1\xe8\xc5h\x8a\xd1\x05\xeb\"\xf1\xaf\x9e\xd1l\x8d\xd5'oC\x1c\xddOB2\xb9K~\x88\xd2\xf6)\xba\x0e\x8b\xd8\xf1\x99\x81_\xe4ӗW\xf2^\x0f\x1cC^\x04\x1ak\x90\x9f\x91G\xa1\xebS.\x91)\x89\x93\x83\fBB\\tlٲ\x19\xff\xf1\xec\xb3H\xe0\"\xa4\xf2\xe8a\xfc\xe0\x99\xa7\xd1\xeanA\xacՎ\\\x97\v1=]\xe8\xac>C\x97ȱ\xc3\xf4\x18\xa1 \xda++\x10\xd8S\x8e'\x9e\xf8\x1e\xee\xfb\xda\xc3\x187\xba\x18\xf68;\x01\xaf\x83\xcf\xdfC\x9ey\xd1\xd1\xdc\x1cP\x030x<\x11\xd9\xea\xa5\xe6l\xe5\xfe4N[~\xd2\xe4\x12\xc96\x11UD\x00{+\xf4\xb9\xd1E\x9d\"\x1a\t\x11\xfbL\xca\x05\xf4\n]\x82)\xecG\xeeH\xda\x02\xb6\xd3\xcd\x00o\xfa꾴\x15\xe3d6s\xad\xcc\xfbs\xe7\xaa᧡۴\xa9\f\xf5\r\xf5\xe8\xec\xeaDWW\xbb\xde\xdea\xb5 \xd6bAg\xd51\xbd\xaf\x10\x97\x8e*\xb9۴e\x03\x8a\xd3\xd2\xe1Js1#\x18d4\x18\xc38A\xc1\xfe\x03\a\xf1\xc9\xfa2\x9c?[\x83P\xbb;\xc49R\x82Z\x97\x9en\xe8\x85q\xf6\xdc\xf1\xa4\xaeֶج\xa2\x89\xbd\xc6C_\xcf^\x00\xd1[\x917\x11\xd1%w\x05\tQD!Kp L\fy{\x90\x14\x9f\x80\xf4\x91#Y7\xc4\xe1\xfa\xf1Y\xefJ\xda\xea\x06\xebB\xc7999:\xf8s\xe7jiy\xc3p1l\xb43-$E&%>)\x11\u07b3'\xd89\xc7\xf53;\xe2턃\v\x8a\xad\x9f~\x84\xfa\xba\xb3\x8c\x15\xe2Q'\x93\xd6Ӊ\xdbgOƦw\u007f\x8f\xfa\x16\x1f&̿=\xc0\xeeB1\xe68s?*\xe2lZ\xae9)\xde\x14\xe3L\xd2\x01\xcb@\x06\xb2R\xf41Rx#2\xcb\"\x82,\x01\xbf\xccE\xefk\xde\vt\xd1W5\x14F\xa0\xcd\r\x1b]\v\u05cbҤ\xb7\xc8d\xc9<\xc9'\xf2\x87\x02E\x035y\xca\x14L\xbf\xe9\vhjnDFF&\x1e\u007f\xe2)\xb8\\#\xf4I\t2\xf8\xe8\"ǽէ\xa1\xfa|:\x19\xbe\x13\x87`\xe6\xc4.X\xfa\x00<UG\xe1\x0f\xb7aRq\x16\x96-\xbb\x0fyY.\x9c8Q\x89\x15?y\raŴ\x91H[\x19NX{\x01\xbfҬ\xc5\xedZ\xfd\xe6<\xc5\xca\x10\x8d\x11LH|D/\x12\xa1l\xe0\xd2[%\xfa\x9ah\x84\xc7\xe2v$\x1c3\xe8\xa2\x10\x91\x80h/\xe2\x8e\xe41\xbd\x93>aRSD\xdbE=]\xbeb\x05\x16.Z\x84\x11\x04:\x95\x13 \xf5db;\b\xac\xb6\xa6\x86\xc1G\xaan\xcdA}\xf6\x1eنo=\xfc%\x8cˈG\xa7\x8f)%ʭ\x18\xad\x04\xa7\x05!N\xb8\x99\xb6hD\n\xa5\xa2\xbdE\xed\xeeFh$\xc7\xe9\x05l\x13\x95\xeb\xee\x1a\x19;2\x9f\x94\xf01\xf3K\xd7RD\xeb%\n\xb20\xceu\xf7xi8\xfcD\x15˧\x11\xb82A\xf2^\xfe\x13'E\x1a{\x8b\x91N#--\r\v\x16.\x8c\xb8\x1a\xd6\x117\xe5\xe7\"\xa2\xbeՍ\xa6\xfa:\xd8Knc}\x05\xbe\xf3\u0558>*\t\vn\x1eK\xff\xcd:d\x90\x85\x13c\xa4\x1b\xe0t\xd3\b2\x0e\xa5!4X\xe30\xb2p\xf4\x01\a\xddR'\xc9\xe8\x955Fs\xbe\xae\x86vc\xd8H\xa0\xc2\x016\x8b~z)\xe2\x8b\xe83!w\xa0\"Ñ]\\d$\"\xa9p\f\xdaz\x04p\x94\x95\x02\x97\xfe\x98,\x96@\x9f\xea'_#\x1f\xf6'\xc9;\xb3\xc9\x023\x83\x13\x998\x19A|sk\xc0\x83\x93Ǐ\x83\xb9j$\x17L\xd4%\xc3}\xf2\x10Fƙa\xb3\x89\xda\x01\xb1\x04jf\xd6S8\xeb\xf1\x85q\xe8\xf8i\xbc\xfe\xea*\xd4\xfaC/\x8d\xbbuѪ\xfb\x8a\x95\xc0\xbe\xffb\xaa\x8d}\xeaErD\v\xbf\xbf\xa2\xd3\xebn\xe7 \x11\x1a\x06\x86\x14m1\xf0Uڈʆ\xe8?\xc3\xceT\xec\xde^\x81)y\xf7\x90x\"#p\xbdO\xfe\x91\x98\xb9o\xd1%\x9f\x0f\"sCβf\x90\xb0;\xb8)SYۈ\xf2M\xe5\xb0%e\xc1\x983\x12Az\x9003\x04gj\x1ai\x1c\xb9``0\xe2\xee\xf6\xe0`\xe5\tl\xfax7j꺰u\xdbnX\x12\x92\xd4y\xdf~\xf2\x8do\x8eI\x95e4$\xe3\xd9\vX\x1e8\x12c\x994\xf0\\d\x88<\xbc\xca\"0\xc2䊤b\x1d\xe3&\xe2\xfd7\u007f\x8d\xd1c\xf2q\xeb\xd4\xc9\x04\xc1\xc9 na\xaa\xb8\xb5\x88~F\xbeG\x85 \xf2LD\x94\x11\x17ժ\xaaɍ\x8d\x1bw\xe0\xd8\xd1CH_\xf0\x00\x12\xb3\v\x11`\xffiSfa\xd5\u007f\xbe\xcb\x15T\x18y\x859ر\xfd \xca?݊\x9c\xac\f̜3\x17\x05\xed\xddhjl\t'\xe7\x8eh\xea\v\xa1W\xa4\xe5a\xc2\xc8\xdc}!:z\x9f\x97{Z\xfd\x19з͐\xf7\"\xa5\"\xb2\xe4!TW\x0ebo\xba\x13/\xbf\xf16\x0e\x9dkԁJ\x8c-&V\xaa\x84%D\xa1(Ҧ\xf3è\x89W\x86\xfeh#\xd0F\x9f\x8a\xe3n?6lلuo\xbf\x85\xc49w!\xfd\xae\a\xb8rc\x02\x9f\"o\xcb\xcdG\xe1\xe3?\xc0\xdf\x0f\xd5\xe27om®\x0e\x03\x1c\xe9y\xb8\xf7\x9e/\xe3ѥKq\xeb\xfcy4\x9c\xaa\xea\xef\x10\x9fx\xb1\xf4\xe3p|Ѵ\xca`\xe9\xbb\xc1\xcec{\xcd\xc9SnB\xff\xaa\x17\x1b\r\xe7N\x8c\x92ju f\xc2\xcd0\xa6\xa4\xe3\xb9\x17\u007f\xcbp\xf1k\x98:i\x12\x97\x83\xe4*\xc9\x10\xb1\xd6\x17\x00\xbc\x86)\xba\x120\xb4q\"ڃ&\x9c\xaekǦOV\xe1\x00\x01g.~\x14#\xe6q\x99ʤ\x83ƺ\xe2\xebM\x063\x92&܄\x1br\xc6@eF\xd5\xe7oC\xeb\xdaRlۺ\x03\t\\F\x1e9x\x14))\x05\x1b\xc7}e^m_z\xfb\x01\xceH\xcfmN\x19\x91\xd5ӺwK|ʍ\x11\xc0\xc2+\x11\xc1\xab.ld\xa2X\x87bl\b玡\x18\x9a\xf1\xfc˯b̘b\x8c\x992\x95y\xb2\"\x1a63\xec\x94\xed\x80?\xa0\x87\x97^\xaf\x1f\xe7\x1a\x1aq\xe6\xd4)T\x1d?\x8c\xe6\x86&\xe4<\xf8\x1d\xa40\xe3\x11\xa4A\x12\v@C\xac\x83\x96\xb5\xb9\xd1@\x03\xc7\x108h\x8dE\x9c?\x16\xfe\xf4\x02\xec-+Ӛ\xd45\x95\xa9\x99Yǖ\xfc˳?Z\xa6\xf4ߕ\xec\a8!\v\xee\xe4lW}k\xd5\xd9x\x896\xa4s\xd9\x06\x89d\x15\x05\xb6\x98\x1e\x99\x82K\x8b$\xf4\xf8\x8ez\x17y/\x16W(Sab\x1c\x1c0\xd2=P\x04\xd3\x16,ũ\xca\x038\xf0\xde\xfbH\xa0\x8ft$q\xa3\xb8\xad\t&f8\x02ta\x01\u007f\x90\xbaKns\xc3-~\xc6\x1c\x1413j3\xc71f\xa6o\xa0\x18K\x91\xb8'J\x81\xdf\xc8\xd14f\xb1\xac̅\x87Ͱ\xd0w'\xe7\xe7\xd5?\xfe\xc2k_<\x9a\x8e\xbao*\x8c?/)\xfd\x00/U\x94֧\xff\xb4ꃃ\xeb\u05ceQ\x9a\xea`H\xc9\xd4\rKt\x80K\xda^\xf1\xabDi\x124X\xcḏZ\xcc\bۋ\xe1\xca(@\x92Ǎ@s\x1d\xba)\xa2\xa6\x9cq\x8c:\xcd0Q\xc6\x1d\\\xa5Y\x9c.\x06>\xf1\xd4U\x02\xa3\xffU\xe82\xb4\xcb\x17u\xfa\xd8zhJ\xf8F\xb3\x95\xf5\xb9\xfe\x8ds\xa2\rj0p\xceݾ<#\xf92\xb0Ҩ\x1f`y0r\xd4\xf8Չi\x99OuU\x1d\xb2:\x99V\tҨ\xc8V\xe7\xb5\x14\x01\xab\x91\xcb\n\tV\x85\xe3\x16\xfaY\x9b\x99\x11P,b\\\xb9|ƾ\xb9\x96\xd5UF\x04\x827\\\x04\xe9\xc1\x86!(fLVk\x179:\x10\r\xe2\xbbU\x8ea h\x93\xd5\n\xaa\x85\xa9]\xf1\xea\xa6a\xa0\xfa\x97!y⦼m\xae\xfc\xfc-\xad\x1b\xd7\xc2 Y3rI\x15\xc29\xf8\xc0\xe2,\xddʠ\x91:}\xc9\xd3\xebs\x11\xae\xc7\xdc\x12h\x880\xea\x16\x9c}Q\xbfe\xadK\x8dс\x8a\xf1\x16\xe4\x92\xc9\xd0\xeb\xe9\xbd\xf6\xedM\xc6\x19\xa4\xc8d\x11\x89\x04=\x89\xf1I\xbe@\x866\xe8v\xcbe\x80)&\xa1\x8c\xfc\t\xafz\xabO\xc0\u007f\xb2\x92V\xf4\xb2*\x83\x8cz\xf5\x8fu\xce^}\xb3KZDz\x11\xf51Qm\x94\x18\x9b:\xe6\x10}\xda e@4\xe3\xbf|\xefzgzFmӾ2\x8ac\x94\xac\xe8u\x90\x9e\xae\xebc\x19+\xfa\xb9rǑ\x9aܒa\x9631\xdde\xa8\x1aU8h\xa3\x01\x01?\x96\xa1\xb4\xa4\x15N,\xeb\xaa>\x8a\xa0\xbbM\a-\x02+\x9f\u007fD\x89\x8c%\xc1\xc8p@G\x94\\\xa7\x8e|mm\xae\x1fqv\xf3\xbb\x8be[e Z\a\x04,\x15\xd3\xc7L\\\xa3\xb6\xb6\xa2\x99\xf9\"\x83\x99\x9d\xfe\x03\x8b\x18\"\xf9D\xb8<\x8c\x81i\xd9t/\xc86\x9a\xd1\x1a{hê_\x9d<\xf0\xceïj\x1a\x97\x16\xfdˠ\x80gM\x9f\xb7\xceሩvs\xb7\x00\x8cWi\x93\xfe\x01\xe5\x82Y\x14\xa6\t\x021r\xc3(\n\r!\xe3:\xddGO\x9e8\x05K\xef\xbb-\xf1\xec\xf65/}\xf8\xccOW\x94\xba\xb5\x9c\xb5\x9aƝ\x84H\x19\x14\xf0\xddyJ{\xe6\xd8\x1b+L\xcc-\aZ\x9a\xb9\x18\xa0%\x1e\xd4\x14D\xbb\x1b\xdeU\x0f\x1e(\xadr\xbdX\"*#IC\x81\x19I\xfd\xf4\xabp\xb1j\x9f;\x99\x97\x88,h\xe8j\xac\xc5ޏ\xdf\xc7\xe4\xe2\x02\xfc\x9f\xef\xddo\x88\xe9\xacz\xe6\xf5\xe5Ϯl\xe9@F\xb4ɠ\x80\xa5BZv\xd6\aNF/]\xcc#\xe9\x9d\xf6]\xadG{\x18\xe6\xd5H \xf21I\x1f$R$V\x97\xda\x01\xdaK&3\x02\xf8\xca\x1c\x16\xdf\x1d\xb5-\x9e\x8e6\xf8\x82\x1a~\xfe\xbbR\x1c9V\x83\x85\xf3'c\xe6\xb8\xcc\x1b\xb7\xbf\xb3*.:̐\x80\xc7\xde0\xbb,1>\xb1\r\xcc\x1c\xca\xd2Nw\x98іWy\xd5\xf7\x87)x\xbe\xf66\x04\xfd\xdd\xe8`\xba\xc6\xd7X\x17\x01\xce\xe7:W\x85tݟ_\xbc\xea\xb33\xc4X\x12\xccF\x8a\x02g|2\xb2\x8a&5\x1b\\\xa36\xfey\xed\xbe\xe0\xf6C\rhk\xeb^U\xb2pѩh\x17\x97EZ\xd1\x17r\xcd\x19\x91\xd4\x164\x18\x9b\x83]\x1d\x892\x93tuö#}\xfbћ\x91\x9d\xcc֠i_\x052\xd5zd\xa5\xa6a\u007fs\x10Yً\x10f*C\x8cT\x04\xac\xb4d\vi4̢W\xa5l\x87\x99\xed\xb0\xbb\x12\x0f\xcd\u007f\xfc\xf1\xfbOlۜ\u007f\xeah\xa5}\xce\xe2%\xd5\x0ec\x8f\x93]uKwCr\xd8r\f\xfeĔ\xa4#Aw\xbd~ \xa7\xbf\xce\r\x93\x1aV\x13.\xc86g\x88\xd9\x14\x1f7\xc6,\xa7\x0e\xe2\x16K#\x1a\xb6}\xa2GZ\xbaPF\x195\xfcn\xf5\x9a\x11\xe3F \x12\xa2\x86\x98\xe2\t\x87B\xe9q\xf0,\xff\xe2\xec\x9d\u007f\xfe\u07b769\xd3m\xf5a_\f\xf3L\x912$\xe0\xe6\xb9\xd0\xfc\xbe\x1eo\x90\xab\x98\v\v\xd7h\xbb\xab\xber-\x0e#7\xaa5\x0f\x97\x80\xb5m\x88\xe5i\xbc8\x8a\xb6\xaf\xc7ǰ\x90:\xab\xf7(\u007f/\x18\xaf\v\xd7+\x8a\x14\x9b\x88\a\x91u\xb2$\x85\x1c6\x9b\xa9\xb9\xbf|\x84۲\xf4\x93\x12\xfa\bC\x02n\xdb\xc3p:菵\xf3l\xa4\xc8~D\xd3\xf4vW\xf9'\x92\xdbVc\xed\x88\x1f[\x88\x1aZSS\xac\t\xb9I\x0ex\xea\xcfsq¤\x1d\t\x17\x95\xd1\xd5F\x87\x1f\x01?\x9c\x81\xc2z N\xfa\xb8\xb9f\x8f\x8f?\xcb6\\\x04D\n\xcfi\x86\xfb\x1eY\x1c\x12p\xa2\x1dJw\x9bǢr\x81-\xfa'\x90\xaf\xa5\x88\x8e\xc9\x11C\xd9Ӎ+\x9a\xc0\xe3\x87)غ~+,\x16\xfaw\xae\x83ecL\x10\xf3P\xa2\xfe\x91\xfaWU\xd8V?\xbe(\xa7\xb0M\xf6\x06\x019X\xfb!\x01{\xf3\x90\xca4h\x9e\x91\xc4\xca$\x8a\x0e\xf7\xfd\f\xd6i\xdf\xe7\xe2^\xc2\x14g\t\x0e\xc4\xd4\xdbc\u243dh\t\xd6\u007f\xb8\x11*w&\x1c\xaet\xdd>\xa8\\\x0fK\x15\x95\x03\x88\x81\x8c\nv\u007f\xe9\xec\xd73\xbf\xb0\x16\xeb\xcb\xc6Z(\xd4\xcd\x033^\xa6~b\xaa\xfaֺ\xf4~H\xc0-\x8d\x8d\xd9\x1dgΏ\xd4\xecv}o\xedZ\xa2-1*\xf2\x91\x81\x04\x84hk\xc6\x17\x98>J\xc8D\x8f9\x11q\xb2\u007f˥\xba\xac\x97U\"\u0557\x8b\xbc\x8f\x00\x1d\x8a\xd7\"m\xc2\x012\x95z\xa0\xb6{\xe0>v0d\xb3\x9b\xf6^\n\xb2\xef\xf7!\xddROCCFPUM1\xb68ٿ\"we\x90\xa1\x88\xe8\xdb\xf5\xc5\xfb\xa8%\x15\x02u\x8e33\x91\xfd\xdd_\xf0\f\x18\x0f\xa0\x12\x1c\x05\x88\xaf\xe8\x96\x04\x80^\xaeb\f\x99$\xf6\xd1\xd3R\x03XCMEw\xdd9\xe4\xa1\xf2!\x01{\xea\xeb\x92U\x9e\b\x8b\x89\x8d\x8d\xe4\xb7d&\xa34] \xedj.\x11\xe0\xe48\xfb\b1\xbf,E\xb4MDY6]\xae\x04X\xe4#Z\xf4H\x8c@e\xe3Nc\xe6\xc4\xd4\xd1\n\xbb\xaa\x9cw\a1\xe0\x91\xc3h\xbb!\x013\xa3h7\x9b\xad\x8a\x99{\xae\xc2]9\x8c\x16\xcdFD;\xb8\x96\xab\xaeG\x17i\xef\xd3\xc5\xf09\x1b\x95\x9a\xc8\xd9-#|\xe7\xce\xf3\xa8R̦\x1f\xa4\xf2\x84\xdb\x10eH\x1d\xae?S\xef\xe8n\xeb\x84-.I7,C\x87)C\x8c\xf29\xbc20O&\xa0E\xcb\xc2\xdcY\xf4w\xb4\x84\xb2\x8a\xc6m\xbc\xd2PC\x02f\xee3\xb1\xb5\xba\x8a\x89\xb16}\xf3Kz\x17\x1ep\x9c\xebV\xae\xc6\xea\xeb\x1bu\xa4@\x0e\x9f\xc9\x19O\xb1\xfe\"(>\xee\x96\xf4\xf8;\x03\xf9\xb3\xbe\xd4z%\u0086\x04\xec\xcc\x1dqT\v\x84\xe0kiԭ\xab\x88\xb3\x89>OV=Լ+\xf5\xfd\xf9\xbc\xe7d\x8b\xfe\x06e\xf2u\x1d\x06\xba\xea\xce1\x15\xec\xa8\x0e:\x92\x19d\r].\x03\xbcq\xa3f\x92_\xa6\xfcfGM\x96\x11\x96[䀗\xa7\x8e\x16P\xcfz\xc8\x16\a\xdd\x00\x01G,\xf6Н\u007f\x1eo%\x80ԏ7\t\r\x1c@\x95\xb0\xd7\xdd\x04W\xce\xc85\xffk$\xce^i\xcc~\xc2y\xe7\x13O\x14Y\x8c\x8e_\"\xe8s\xb5\xb9[\xb3\xda\x03\xfe\fO\xbb\x97g&\xba1륿\xc3Ġ\xc1ra\x11\x1b`\xe8\xa5皯4\xc25\xbc\xd7\x05\xe8B\xbb\x81}\xbf\x8cM\x01\xe7\x91<oC-\x9aV\xbe\xec\x9fzCɴ\xdf<\xb2\xe0\xf0\x95\x86\xeb\xb5\xd2/\x94nK\xfa\xc3Ͼ\xbf\x166kA\xd6\xfcŰM\xcfC^\xd1\x18\xf8k\x9b\xb0\xfd\x99\xc5\xe8\xdcY\x81\xa4y\xf3\xb9\xc7CK-J,A\xafH\xf6?\xb0\x88(\v\x87e\x12\f\xf4\xdbj\x97\a={ʐ\xa0y\xffR|\xc3]ǇCJ\xafHw\xa6\xc6\a\x8a\xa6\xcf:\xd9\xe1n\x86e\xfc\x04$Ϻ\x9d\a4-P\xeb\x8e#ѕ\x86]\xbfx\x1aAf>4\x8a6\x83\x11=*\x1a\xce\x00\x9f\xad\xce\xe53\x1a&gu\xb1\xf40\xbb\xc13\xd7\xc1\x83[ko\\x\xfbs\x8fM\x1b\xdeo\x9a\xf4\xb6Q\xa2\xe4\xe7s˗\xdc\xf5\xcf^\xaf\uf5eeb\x1e\xadG\xb8ì\x18\xf6gO\x9c\xa2\xee\\\xf7\xb7[\xac\x99\xe30\xfe\u007f?\a\x0f\x93zz\xe2\xecB\xeb\x886\xf5\xeb*\xda\xe55]\xc9H\xe1#m\x05\x03Q\xaa\x90\x84\x9c\xba\xdfe\x84\x12\xe4\x91\f\x13\xb7G\x83{\xcb\xd1\xf8\xf1\xbbg'\x94\xcc}\xecŧ\x1e^?܁.\xa3\xf2\u007f4ͼ뷿[\xa8x:B\xb3\xee\u007f\xb4R\xc9H\tڸ|>\xb4q\xe3\xafV\xff\xf2\xc7_N\x9au\a\xc6>\xf5\x03tzD\x8f\"\x1c\xb8ހ\x85x\x11=9\x95#˞\xa0\x1c\xb0!\xa5\n\xaf\x1aw\x1b\xbd\xbb\xcaеwӦ@\xc8\xf3h\xf9\x9bo\x9e\x94\xfa\xc3-\x97\x01\x1e\xac\xe1\xf3\a\u038d\xafݹe\xe5'/\xfdntւ\xfb\x91\xb7\xecIt\xfa\t\x98\xb3\u007f\xbd\x01\x8b\x89\x10\xc2\xf8kLn\xe6\xd1\x12\xcb0\x81 \x94\x96Z4\xac~\x1d\xad\x15e\xda\xec\a\x97>\xfc\xe2\xe3\xdfxc0z\a{\xdek\xb4\x06\xab\x10}\xfe\xaf\x93r\x0e\xbfT\xdb\xf9\r~\xff\xbf\xef>\xff\xd39&\xbb\r\x05\x0f=\x82f\x8f\xcc>\xa9\x8a0;Z\xfd\x1a\xae2m\x91N$>\x0e\xd3(\xea\xbb\xfd\xb4Ć\xa0\x0f\x1d\x9f\xaeA\xf7\x81\u0378m|\x12\x0ew\xe5+;֭\xf9\x06U\xf0o\v3\x86\xfe\x9dҥ\x84\\U\xe2u\xf5\vϝ\xff\xee\x1fWn\xb3''\a\xf6\xbe\xf9\x87i\x89\xf1.\xa3s\x1cw\xf7\xe5Pu\x14\xb0\xb0\xe6\x1a\x8b\xde\xf4\xc2\xe4ɯM\r\x86 ln7\xba\xb6\xafC\xfdG\xa5\xaa\xa1\xa7Ky\xfa;\x0f\"3\xce\xc4cI\xe7\xd2C)qk\xb6\xbc\xf5\x97\xba\xab\x19\xee\xaa\x00K\xc7+\x9f_\xd1\xfa\xf8\xaaw\xcam\x06Sx\xc7˿\x9d㫩Q\x92'π\"\x87\xfa\xa8pB\xb4t\xaa\a\xf5\xfc&\\\xd39\xa7\xeb\xbb\x18!~\x13\xbf\xc2\xffe\x8edQ(\xaf\xf4\xa8\x89\x0f\xcd<\x8e\xa4v\xb4 x\x9a\x87H\x8f\xeeA\xfb\xee\xad\xf0\x9c:\xf6\xfb\xb1\xb3J\xbe\xffi\xe9ۡ\x18\xbbc\xea\xec[nĞ\x9d\x87M\xcd\xe7\x9aꊟ\u07ba\xf9\xe8;+\xa2\xd3\xcd\x1e\x87.\xfa\xa4\x0e]e\u0dff\xe1\xf6\xc5\xe9W\xff\xfeՃ\x9f\xae{\xb63\x1c\x1c\x9f\xf1\xb5\u007f\x82s\xdcL\x80?\xd8\x10\xa5\v\v0كױQ\xecI\x92I|\xa7\x0e\x943Ó-\xaa\xfcv\x89\xf5\xccL\xaf\xca\xe9!\xf0\xa3֝F\xa8\xa1\x8e\xc7\x05\xdb\xea3ғ?q\xe5\xe6\u007f\\|\xd3\x1d\x1b\x1f\xcaRj\xa4\xb7Y\xf3\xee|\xff[\xcb\xe6/\xac:U\x83\x8a\x93\xad\xe5_}\xfeO_\xe2\xe6ߠ\xfb\xc1\x97R?l\x1d\xbe\xb4\xe1\xbf(\nm7\xdez\xbdA۰\xee\xd7?\xffn\xdd{\xa5O\xb4n\xd9촎\x9f\x8c\xd4\xe2\xa9\xe4\"\x03\xd3T\x9e\xb9\xa6.\x1ay\xf0\xd2\xcbIp\x9f?\x01\x9b3\x11\x01O\a\xfcUǑ6\x8d\x13\xd4\xd6\n\xef\x89#\xf0՝\xf6\xda\x14_\xadŨ\x1c\xcc.(\xf8[\xf1\xec%U\x16\x87\xb3\x87\xe7V\x9aL\x0e\x9ed\x88\x14-\xbdd\xe1\xb7_}\xef\xa3)\xf1\x0e[frza 6\x11\xf2c\xac\xb3\x17\xde_\xf1r\xcd\x1c\xee\xdb\xf3r\xfe\xe3\x06\xe9\xa7Q\xbci\xfd+_>\xb5y\xd32o\x977+l\x8b\xb1\xc4\x17\x8eGRn\x1e\xbc\xfca\xb4\x85\x87R\xaa\xff\xfe2\xa6Μ\x8d\xd9S\xf3\xf0\xdf+~\x86\xf8I7\xf7\x8c(,ؒ\x92\x9c\xb8%77\xffp\xee\xb8)\xdby\b\xd4\xc7m\xdeP\xc8\x06\x9b\x12\xf4\xda돷\xb6d\x95d\x89w\nKr\xae\xb4T3\xee\xb7m\xbd\xbd\xe9\xf8\xb1Q\xb3\xee\\\xb0\xc1\x99\x9c\xd6|\xf7\b4S%\x86%\xd6\xd7\x05p_\xf0/\x9cגNW\x94巜>Z\xd0Py$\xcb\xebn\x89i\xac=o\xb7ڝ\x85֠o\xf6\xd8IcG\xfcj\xf9\xa3\xf8\xfdK\u007f\xc1\xc1V\xfb{\x8b\xbf\xff\x93'\x8f\xff\x11\r\xd3\u007f\b\xf3\x9d\x11\xa9\xe9\xdb\xdde\xf7\f/Ŭ\x89\xd2\x0f\v\xe0\xa5\x1d\\\xb3H_\xdaQ\xf4\xfb\xf7\xb2\x157\xef\xe5\xb3;\xfa,z}\xec\xbf߸\xf7\xe0\xea\xb7\xdf\xea\xe8\xe84M\x9b<\x11\xdbJ\xcbS\x03\xb4k\xe9\v`<__?,\x03:\\NFǼ\xf4\xda\x1bK_\xfa\xe2Z\xbes\xf6\r\xf2\xbb\t\xe1\xc2@\xed\xd3\x1f\xfb\xfa\xdfbRR?\u07b8\xb1\x9c\x87\xb9U\xf8\xba\xbb\x13\x98a5\x14%Ø\x98\x9e~M\x1c\x1bh\x9c\xa1\x9e]W\xc0\x9c}u\x19\xff\xf1\x91\xc1\xb8\xb0\x9c\xefK\xee]\xf2\xc7\x0f\xb7\x9e\f\xaeZ\xbd\x99\xc7Ӎ=L\x88z\x9by\x96\x99D\xf6\xee\x16\fE\xf0g}w]\x01\x0f\x87\x98\x89\xb3\xe7~:궇֟j\xf6cD^\xd1{L\xa6t\xe1H\xc4 \r\xa7\xfdg\xad\xf3\xff\x01\xc9WS\x91G}\n`\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,5 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-
-package images
-
-var Tree_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x80\x00\x00\x00\x8d\b\x06\x00\x00\x00\u007f\xa0r\x15\x00\x00\x00\x01sRGB\x00\xae\xce\x1c\xe9\x00\x00\x06\xc5IDATx^\xed\x9d͋\x1dE\x14\xc5\xfb\xa9\x8b8YH\x02BD\t\U0004b415\xa0\xae\r\x82\xe8&\v\x05\xd7\x01\x97.\xfck\\\xb8\xf2?\x10t\x11\x10\xdc\b\xaeUp%\xe2G@\x04\x03B\xe2,f\f\xc1/\x86n\x98Tu\xf5ԩ۷\xba\xfbu\xff\xb2̻u\xab\xeaԩsϫ\xa9\x9a\xd95\x1b\xf8w\xe3ӷ\xff\x9bj\x9a\xb7\xde\xf9l7U_\x1e\xfd\xec\xd5`\xad\x13\x86\x00\xc3\xc8A\x00+\xab\x06ڡ\x00\u0380z\xa4C\x01P\x00<\xc0\x00\aVY\x02^y\xffr\xb0\xe0\x17^\xbd\xe6!$R\x8e{_\u007f\x1f\xc4}\xf3ѯ\x8b\xc6xу\x93\x10O\x04A\x00\x1d9\b\xa0c%E\xa2\x00\x12Lu\x83P\x00\x1d߽S\x80\x9b\x9f\xbf\xd73tw\xef\xdf;sƿ\u007f\xf1\xad\x8e\xc8\xc8ȧ\xde|9Ȑ\xea{I\xbe\x00\x02\x8c\\\xf0\xb89\x04p\x064N\x87\x02\xf8\x02\x8c\x02\xf8\xe2٠\x00#\x00M\x9d\xd8]<w!Ș\xab\xf7\xa9\xeeSu8^\xa8\x11\xc3\x1e\xddt\xce\xe3\xe3E)\x00\x04\x18ͥ\xe2\x04\x10\xa0\x182\xff\x06(@\x87)\n\xe0O\xae\\F\x14 \x87\xd0\x04\x9foV\x01\xe2\x13;/c\x16\x9b>%ol6k\xae\xbbbd\xa7\"Ŭ\n\x00\x01\x86i\x06\x01FlA\x14@\a\x0f\x05谢\x04\xe8\xa41G\xe6\xaefY\x0fl<v\xbcR\x97\xcd\x13whX\xab$L\xaa\x00\x10\xc0\xce\x04\bp\x06v(\x80\x9dX(@\x87\x1d%\xc0N\"\xb9%%@\x86\xaa\x17\xb8w%\xc0r\xac\xab\xdc\xdcI\xdd\xf0\x8d\xef\xe1)\a?\xf6\xa5\x98\xa7%\x04\xe8p\x87\x00\xbe\x04\xac\xe6\x01P\x00߅B\x01P\x80*\x9b\xd5-i\xbc\xe3S'k\x1eN;\xe5\x13\xe2\xb2p\xee\xe2\xe3\xbe\xdbo\x86l\xf7\xef\xfe\x15\xf4Z\xeb\xbd\x01\x04\x98aq\x95.!\xc0\x00J(@\v\x8c\xd7\xdb\x02\x14@َ3\xc4,Z\x01,\x0e_\xc10\x9e\xf4I\x9b\xb8\xf6)ybO\x90\xcaay1\\\xcb[(\xf3V\xce6,\xdf\x14L\n\x00\x01\x14\x1a\xea1\x10\xa0\xc3J\x01B\x81\x15\x05PPjcP\x00\x1d\xab\x86\x12ЁE\t(`\x8d\x10\xaa(߬\x1e\xa0\xd6!O\xce\xe9\x9e`\xa7\xbc\xb5Sb\x84uȆX\x8cc6\xe9\x80\xd1U\x16<\x97[1\x85R\t\x80\x00-\xd4\x10\xa0\xa3\x9c\xd71/\n\xd0\x02\x9a\xfa\x9a\x8a\x02tdS\xe4]\x89\xc9ɥ\xf2\xf9f\x15\xe0\x8d\x8fߪ\xf2{\xf6\x94C\x1e\x8f\x9d\xa0,n*F\xb9\xa0b͝k\xe71o7\x0f\x00\x01r\xcb\xe5\xff9\x04\x18(\x01\xfeP\x0fgD\x01:lP\x80)i\xd7\xf6\x85\x02\xa0\x00\xa3Y\xe7\xe6\x01\xe2W\xbc\xa3G6\x90\xc0\xcaz\x8fw}\x96\xdbJ֧l\n~\x96\xf2c\xb9# \x1d\x04A\x80\xf4\x92A\x00\x85\xca\x051(\xc0)X(@\x01q(\x01-Xn%\xc0\xe3\tW-y\xf4:\x86\x8e\xf9\xa5\x90H\xf1\t\x96\x87\xaa\x05\\/\n5\x9b@\b\x90\xc6\x19\x02t\xb8(\xbb[\x89)\xa2s\x17\x8c\x02訡\x00:V\xcdfK@N\xee\v0\fB\x15\x17ku\xfd\xd61\xcdѮ\x96\x12*s\x91\x14\x00\x02(P\xdac \xc0\x19ء\x00vb)-Q\x00\x05\xa5\xca1(\x00\n\xd0C`*\xe53+\x80\xc7a\x86b\x02\x0f^x6\x00\xe7\x89\xe7\xc2?\x0eQysN\x92~i\x8fYcR\xecR&\x10\x02\xf8q\x03\x02\x9c\x81%\nЂS\xeb\xc5Q\nz\x14\xc0osK\x99\x16\xaf\x00O\u07fcһ\xf1{\xe9\xfc\xbf\xc1\xe4❪\xcc\xfc\xf8\xa7۽\xb0;G\x8f\x04\xff\xe7\xd1\xcfI\xc2%y\x87\xc3_\xc2?b\x99\xc2a\xaa\xeb\xe5\x8a\xd9\xdcA\x00\x85\xcez\f\x04\xe8\xb0B\x01Z P\x80\x876\x0f%\xa0\x05\x83\x12Б\x02\x0f\xa0\x97\x16%\xd2\xf2+s\x93\x1e \xee,^(e0\xf1nWڤb\xbcHb\xed\xbf\xb4],\xf9\n\x0e)|\x95v\xf1خ]\xbf\x1a\xfc\x97\xf2\xf5\x12\x02\x94\xaep&\x1e\x02t\x00Y\x18\x8c\x02\x9c\"`\xc1\x0f\x05p\xde͖t{\xa7\x00\x97\xde}\xc6\xe5\xe9\xf7\xa3\a\x8fY\xf0ʶ\xb1\xf8\x8flR\xa7\x00\xcb.u꺉w{*\xaf\xf2\xfb\x11w\x10\xc0\xbe$\x10\xe0!\xecP\x00;\x91,-Q\x00\vj\xcemV\xa1\x00I\xe7\xed\xe4\v\xe2\xdc9\x95\xf0\xfa>\xec\xbc\u0383\xe9j\x8e\xd7r\xfe\xf1\xe7w?\x06c\xfd\xe38\xfc\xe1[j\"\xc9\xd7\xc1^\xbe\x00\x02ة\b\x01\x9c\xcf\x13\xecK1\xdc\x12\x05(D\x95\x12\xa0\x03\x86\x02\xa0\x00\x01[\x94K9n\x1e \xe6i-O\x10\xf7\xf3\xe4Ax\x13\xe9\xe4\xf3\xd8\xc8\xe4TD\xdfcu\"\xe3\x9d\xfb\xe0\xe8\xef:\x1d%\xb2>8\noU\x1f\xee\x0e{Qw>\xf9-\xf0}ү\x88\x81\x00\xfa\x1aB\x00\x1d\xab^$\n0\x02\xbc\xa6iP\x80q\xf8\xb9\xb4^\xa5\x02(\xc8X\xcaD\xbc㕃\ve,\x16\x9f\xf0\xcf\xf1t\xb5Z\x99\x83GL\\\xef\xe5\x83 K\xe7\x10\xc0\x82Z\xdd6\x10\xa0\x00_\x14\xa0\x00\xacT(\n0\x12\xc0\n\xcdQ\x80\x02PQ\x80\x02\xb0j\x85ZT\xa4\xd6X\xa6̫\xec\xd4Z\xe3\x91\x0e\x82ju\x1e\xe7\x85\x00S!}\xda\x0f\x04\x98\x1e\xf3\xec\xf1\xec\x94C\x82\x00S\xa2=\xd0\x17%\xa0`\x11\xe6,\x13s.T\x01DE\xa1\x8bR\x00e\xe4\x10@AI\x8f\x81\x00:V\r\nP\x00V\xadP\x14\xc0\x17ٽS\x00\xcb\xf4_\xbb\xfa\xbc\xcb맯~\xf8yux\xadnB)\x82@\x80\xe1m\x03\x01\n$\x05\x05(\x00kI\xa1(\x00\n\x80\a\x18\xe0\x00%\xa0\x03\xe6\xa5\x17/gE\xeb\xc3[_\xae\x0e\xaf\xd5M\xc8j\x02!@\x96\xff\xfb\x1b\xa0x\x00\b\xb0\xbf\xeb\x9b\x1d9\x04\xc0\x04\xf6L\xa0\xb2\xe3c\xd8\xf0\x00ٽ\xb6̀\x94\x02@\x80v\xad6k\x02!\x00\x04(\x96+J@1d\xcbh@\t\xc0\x04b\x029\t\f\x11\xc0\x03\xe0\x01\x8a\xeb\x13\x1e\xa0\x18\xb2e4\xc0\x03l\xdc\x03|p\xe3u\x97\x9f\x06\xa2\x00\xcb\xd8\xd0ţ\x80\x00(\x00\n\xb0\xe5o\x01(\x00\n\x80\x02\xa0\x00\xc5֡\xd7\x00\x138\x1e\xc3Y2P\x02(\x01\x94\x00J\xc0x\xf1\xa1\x04\x8c\xc7p\x96\f\x94\x00J\x00%\x80\x120^|(\x01\xe31\x9c%\x03%`c%\xc0k\xc1sl]\x83\"\xac\xf2R(\x04\xc8Q\xf7\xf4s\b\xa0c\xb5ʓA\b\x00\x01F \xb0Ц\x94\x00}aV\xa9\x00\xf1\xf4\xbd\b\xb1\x06\xd3\x17c\x03\x01\xf4\xcd\xd2@\x80\x02\xb0\x96\x14\x8a\x02l\xec\x1c\x80\x12\xa0o\xbf\xff\x01\x98+x\xb2\x9d\\\xde+\x00\x00\x00\x00IEND\xaeB`\x82")
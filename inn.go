@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+
+	"github.com/mariuseis/go-inn/shop"
+)
+
+var (
+	innWallColor    = color.RGBA{0x5a, 0x3a, 0x22, 0xff}
+	innFloorColor   = color.RGBA{0x3a, 0x24, 0x14, 0xff}
+	innPlankColor   = color.RGBA{0x2c, 0x1a, 0x0e, 0xff}
+	innCounterColor = color.RGBA{0x8b, 0x5a, 0x2b, 0xff}
+	innWindowColor  = color.RGBA{0xf0, 0xc8, 0x60, 0xff}
+)
+
+// enterInn switches to the inn interior between two levels.
+func (g *Game) enterInn() {
+	g.mode = ModeInn
+	g.shopMenu = &menu{}
+	g.shopMessage = "WELCOME, TRAVELLER!"
+}
+
+// shopItems returns the menu lines: every item with its price, then the
+// way out.
+func (g *Game) shopItems() []string {
+	var items []string
+	for _, it := range shop.Items {
+		price := fmt.Sprintf("%3d", it.Price(g.upgrades))
+		if it.SoldOut(g.upgrades) {
+			price = "SOLD OUT"
+		}
+		items = append(items, fmt.Sprintf("%-13s%s", it.Name, price))
+	}
+	return append(items, "LEAVE")
+}
+
+func (g *Game) updateInn() {
+	g.shopMenu.items = g.shopItems()
+	i := g.shopMenu.update(g.menuNav())
+	switch {
+	case i < 0:
+	case i == len(shop.Items):
+		g.levelIndex++
+		g.startLevel()
+		g.mode = ModeGame
+	default:
+		if err := shop.Items[i].Buy(&g.coins, &g.upgrades); err != nil {
+			g.shopMessage = strings.ToUpper(err.Error()) + "!"
+		} else {
+			g.shopMessage = "THANK YOU KINDLY!"
+		}
+	}
+}
+
+func (g *Game) drawInn(screen *ebiten.Image) {
+	screen.Fill(innWallColor)
+
+	// windows
+	ebitenutil.DrawRect(screen, 60, 80, 80, 100, innWindowColor)
+	ebitenutil.DrawRect(screen, 500, 80, 80, 100, innWindowColor)
+
+	// floor
+	const floorY = screenHeight - 3*tileSize
+	ebitenutil.DrawRect(screen, 0, floorY, screenWidth, screenHeight-floorY, innFloorColor)
+	for x := 0; x < screenWidth; x += 2 * tileSize {
+		ebitenutil.DrawRect(screen, float64(x), floorY, 2, screenHeight-floorY, innPlankColor)
+	}
+
+	// the innkeeper, a gopher in warmer colours, behind the counter
+	gopher := assetImage(imagePlayer)
+	op := &ebiten.DrawImageOptions{}
+	flipAsset(gopher, op)
+	op.GeoM.Translate(440, floorY-110)
+	op.ColorM.Scale(1, 0.8, 0.5, 1)
+	screen.DrawImage(gopher, op)
+	ebitenutil.DrawRect(screen, 380, floorY-60, 200, 60, innCounterColor)
+
+	// the player, just come in
+	op = &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(80, floorY-75)
+	screen.DrawImage(gopher, op)
+
+	const title = "THE GO INN"
+	text.Draw(screen, title, arcadeFont, (screenWidth-len(title)*fontSize)/2, 2*fontSize, color.White)
+	text.Draw(screen, g.shopMessage, smallArcadeFont, 200, 4*fontSize, color.White)
+	g.shopMenu.draw(screen, 160, 6*fontSize)
+
+	coins := fmt.Sprintf("COINS %d", g.coins)
+	text.Draw(screen, coins, smallArcadeFont, screenWidth-len(coins)*smallFontSize-8, screenHeight-8, color.White)
+}
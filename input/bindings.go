@@ -0,0 +1,176 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mariuseis/go-inn/persist"
+)
+
+const (
+	// Version is the version of the controls file.
+	Version = 1
+	// Slots is the number of key bindings each action can have.
+	Slots = 2
+)
+
+// FileName is the name of the key bindings in the game's config directory.
+const FileName = "controls.json"
+
+// MouseLeft is the name used for the left mouse button, which can be bound
+// like a key.
+const MouseLeft = "MouseLeft"
+
+// A Chord is one or more key names joined by "+", all of which have to be
+// held, e.g. "Control+R". An empty chord is an unused slot.
+type Chord string
+
+// Keys returns the names of the keys in c.
+func (c Chord) Keys() []string {
+	if c == "" {
+		return nil
+	}
+	return strings.Split(string(c), "+")
+}
+
+// NewChord joins key names into a chord.
+func NewChord(keys ...string) Chord {
+	return Chord(strings.Join(keys, "+"))
+}
+
+// Bindings maps every action to the chords that trigger it, one per slot.
+type Bindings map[Action][Slots]Chord
+
+// DefaultBindings are the controls the game ships with.
+func DefaultBindings() Bindings {
+	return Bindings{
+		MoveLeft:  {"A", "ArrowLeft"},
+		MoveRight: {"D", "ArrowRight"},
+		Jump:      {"Space", MouseLeft},
+		Fire:      {"F"},
+		Restart:   {"Control+R"},
+		Pause:     {"Escape", "P"},
+	}
+}
+
+// Conflict returns the action, other than a, that c is already bound to.
+func (b Bindings) Conflict(a Action, c Chord) (Action, bool) {
+	if c == "" {
+		return 0, false
+	}
+	for _, other := range Actions {
+		if other == a {
+			continue
+		}
+		for _, bound := range b[other] {
+			if bound == c {
+				return other, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// Bind sets the chord in one of a's slots, taking it away from any other
+// action it was bound to.
+func (b Bindings) Bind(a Action, slot int, c Chord) {
+	if c != "" {
+		for _, other := range Actions {
+			chords := b[other]
+			for i := range chords {
+				if chords[i] == c {
+					chords[i] = ""
+				}
+			}
+			b[other] = chords
+		}
+	}
+	chords := b[a]
+	chords[slot] = c
+	b[a] = chords
+}
+
+type file struct {
+	Version  int                `json:"version"`
+	Bindings map[string][]Chord `json:"bindings"`
+}
+
+// Read decodes key bindings. Actions missing from the file keep their
+// default bindings; known reports whether a key name exists, chords with
+// unknown keys are an error.
+func Read(r io.Reader, known func(key string) bool) (Bindings, error) {
+	var f file
+	if err := persist.ReadJSON(r, Version, &f); err != nil {
+		return nil, fmt.Errorf("controls: %w", err)
+	}
+
+	b := DefaultBindings()
+	var problems []string
+	for name, chords := range f.Bindings {
+		a, ok := ParseAction(name)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown action %q", name))
+			continue
+		}
+		if len(chords) > Slots {
+			problems = append(problems, fmt.Sprintf("%s: at most %d keys, got %d", name, Slots, len(chords)))
+			continue
+		}
+		var slots [Slots]Chord
+		for i, c := range chords {
+			for _, k := range c.Keys() {
+				if !known(k) {
+					problems = append(problems, fmt.Sprintf("%s: unknown key %q", name, k))
+				}
+			}
+			slots[i] = c
+		}
+		b[a] = slots
+	}
+	for _, a := range Actions {
+		for _, c := range b[a] {
+			if other, ok := b.Conflict(a, c); ok && a < other {
+				problems = append(problems, fmt.Sprintf("%s and %s are both bound to %s", a, other, c))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("controls: %s", strings.Join(problems, "; "))
+	}
+	return b, nil
+}
+
+func (b Bindings) Write(w io.Writer) error {
+	f := file{Version: Version, Bindings: map[string][]Chord{}}
+	for _, a := range Actions {
+		chords := b[a]
+		f.Bindings[a.String()] = chords[:]
+	}
+	return persist.WriteJSON(w, f)
+}
+
+// Load reads the key bindings at path. Without a usable file the player
+// gets the defaults.
+func Load(path string, known func(key string) bool) (Bindings, error) {
+	var b Bindings
+	err := persist.Load(path, func(r io.Reader) (err error) {
+		b, err = Read(r, known)
+		return err
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return DefaultBindings(), nil
+	}
+	if err != nil {
+		return DefaultBindings(), err
+	}
+	return b, nil
+}
+
+func (b Bindings) Save(path string) error {
+	return persist.Save(path, b.Write)
+}
@@ -0,0 +1,166 @@
+package input
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// knownKeys accepts the keys the tests use.
+func knownKeys(key string) bool {
+	switch key {
+	case "A", "D", "F", "P", "R", "X", "Space", "Enter", "Escape", "Control", "Shift",
+		"ArrowLeft", "ArrowRight", MouseLeft:
+		return true
+	}
+	return false
+}
+
+func TestChord(t *testing.T) {
+	for _, c := range []struct {
+		chord Chord
+		keys  []string
+	}{
+		{"", nil},
+		{"F", []string{"F"}},
+		{"Control+R", []string{"Control", "R"}},
+		{"Control+Shift+R", []string{"Control", "Shift", "R"}},
+	} {
+		if got := c.chord.Keys(); !reflect.DeepEqual(got, c.keys) {
+			t.Errorf("%q: keys %q, want %q", c.chord, got, c.keys)
+		}
+		if len(c.keys) > 0 && NewChord(c.keys...) != c.chord {
+			t.Errorf("NewChord(%q) = %q, want %q", c.keys, NewChord(c.keys...), c.chord)
+		}
+	}
+}
+
+func TestDefaultBindings(t *testing.T) {
+	b := DefaultBindings()
+	for _, a := range Actions {
+		if b[a][0] == "" {
+			t.Errorf("%s has no default key", a)
+		}
+		for _, c := range b[a] {
+			if other, ok := b.Conflict(a, c); ok {
+				t.Errorf("%s and %s are both bound to %s", a, other, c)
+			}
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	b, err := Read(strings.NewReader(`{"version": 1, "bindings": {
+		"fire": ["X", "Enter"],
+		"restart": ["Control+Shift+R"],
+		"pause": []
+	}}`), knownKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := DefaultBindings()
+	want[Fire] = [Slots]Chord{"X", "Enter"}
+	want[Restart] = [Slots]Chord{"Control+Shift+R"}
+	want[Pause] = [Slots]Chord{}
+	if !reflect.DeepEqual(b, want) {
+		t.Errorf("got  %v\nwant %v", b, want)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	for _, c := range []struct {
+		name, data string
+		problems   []string
+	}{
+		{"unknown action", `{"version": 1, "bindings": {"dance": ["X"]}}`, []string{`unknown action "dance"`}},
+		{"unknown key", `{"version": 1, "bindings": {"fire": ["Control+Q"]}}`, []string{`fire: unknown key "Q"`}},
+		{"too many keys", `{"version": 1, "bindings": {"fire": ["X", "Enter", "F"]}}`, []string{"fire: at most 2 keys, got 3"}},
+		// the default of an action left out can clash too
+		{"conflict", `{"version": 1, "bindings": {"fire": ["Space"]}}`, []string{"jump and fire are both bound to Space"}},
+		{"every problem", `{"version": 1, "bindings": {"dance": ["X"], "fire": ["Q"], "pause": ["A"]}}`,
+			[]string{`unknown action "dance"`, `fire: unknown key "Q"`, "move-left and pause are both bound to A"}},
+		{"version", `{"version": 2, "bindings": {}}`, []string{"unsupported version 2"}},
+	} {
+		b, err := Read(strings.NewReader(c.data), knownKeys)
+		if err == nil {
+			t.Errorf("%s: no error, bindings %v", c.name, b)
+			continue
+		}
+		for _, p := range c.problems {
+			if !strings.Contains(err.Error(), p) {
+				t.Errorf("%s: %q does not mention %q", c.name, err, p)
+			}
+		}
+	}
+}
+
+func TestBind(t *testing.T) {
+	b := DefaultBindings()
+
+	if a, ok := b.Conflict(Fire, "Space"); !ok || a != Jump {
+		t.Errorf("Conflict(fire, Space) = %v, %v, want jump, true", a, ok)
+	}
+	if _, ok := b.Conflict(Jump, "Space"); ok {
+		t.Error("a chord conflicts with the action it is bound to")
+	}
+	if _, ok := b.Conflict(Fire, ""); ok {
+		t.Error("empty slots conflict")
+	}
+
+	// binding takes the chord away from jump
+	b.Bind(Fire, 1, "Space")
+	if b[Fire] != [Slots]Chord{"F", "Space"} || b[Jump] != [Slots]Chord{"", MouseLeft} {
+		t.Errorf("fire %v, jump %v", b[Fire], b[Jump])
+	}
+
+	// an empty chord clears the slot and leaves the others alone
+	b.Bind(Fire, 0, "")
+	if b[Fire] != [Slots]Chord{"", "Space"} || b[Jump] != [Slots]Chord{"", MouseLeft} {
+		t.Errorf("after clearing: fire %v, jump %v", b[Fire], b[Jump])
+	}
+
+	// moving a chord between the slots of one action
+	b.Bind(Fire, 0, "Space")
+	if b[Fire] != [Slots]Chord{"Space", ""} {
+		t.Errorf("after moving: fire %v", b[Fire])
+	}
+}
+
+func TestBindingsRoundTrip(t *testing.T) {
+	b := DefaultBindings()
+	b.Bind(Restart, 1, "R")
+	b.Bind(Pause, 0, "")
+
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Read(&buf, knownKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, b) {
+		t.Errorf("got  %v\nwant %v", got, b)
+	}
+}
+
+func TestLoadBindings(t *testing.T) {
+	dir := t.TempDir()
+	b, err := Load(filepath.Join(dir, "missing.json"), knownKeys)
+	if err != nil || !reflect.DeepEqual(b, DefaultBindings()) {
+		t.Errorf("missing file: %v, %v, want the defaults", b, err)
+	}
+
+	path := filepath.Join(dir, FileName)
+	if err := os.WriteFile(path, []byte(`{"version": 1, "bindings": {"fire": ["Nope"]}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	b, err = Load(path, knownKeys)
+	if err == nil || !reflect.DeepEqual(b, DefaultBindings()) {
+		t.Errorf("broken file: %v, %v, want the defaults and an error", b, err)
+	}
+}
@@ -0,0 +1,184 @@
+package input
+
+import "sort"
+
+// Button and axis indices of a gamepad in the standard layout, as in the
+// W3C Gamepad spec. A GamepadSource maps its controllers to them.
+const (
+	ButtonA     = 0
+	ButtonB     = 1
+	ButtonX     = 2
+	ButtonY     = 3
+	ButtonBack  = 8
+	ButtonStart = 9
+	ButtonUp    = 12
+	ButtonDown  = 13
+	ButtonLeft  = 14
+	ButtonRight = 15
+
+	AxisLeftX = 0
+	AxisLeftY = 1
+)
+
+// DefaultDeadzone is how far a stick has to be pushed from the centre
+// before it counts, so that worn sticks don't drift.
+const DefaultDeadzone = 0.25
+
+// GamepadSource is the part of a gamepad API the game uses. The game reads
+// ebiten's gamepads through it; anything else can stand in for them.
+type GamepadSource interface {
+	GamepadIDs() []int
+	// Axis returns the position of a standard axis in [-1, 1].
+	Axis(id, axis int) float64
+	// ButtonPressed reports whether a standard button is held.
+	ButtonPressed(id, button int) bool
+}
+
+// Pad is the state of the gamepads in one tick: the standard buttons held
+// on any of them, with the left stick folded into the d-pad.
+type Pad uint32
+
+const (
+	PadUp Pad = 1 << iota
+	PadDown
+	PadLeft
+	PadRight
+	PadA
+	PadB
+	PadX
+	PadY
+	PadBack
+	PadStart
+)
+
+// padDirections are the bits that are moved rather than pressed.
+const padDirections = PadUp | PadDown | PadLeft | PadRight
+
+var padButtons = []struct {
+	button int
+	pad    Pad
+}{
+	{ButtonA, PadA},
+	{ButtonB, PadB},
+	{ButtonX, PadX},
+	{ButtonY, PadY},
+	{ButtonBack, PadBack},
+	{ButtonStart, PadStart},
+	{ButtonUp, PadUp},
+	{ButtonDown, PadDown},
+	{ButtonLeft, PadLeft},
+	{ButtonRight, PadRight},
+}
+
+// Gamepads reads every connected gamepad as one, so that any of them can
+// be picked up and played with.
+type Gamepads struct {
+	Source   GamepadSource
+	Deadzone float64
+
+	prev, cur Pad
+	connected []int
+}
+
+func NewGamepads(source GamepadSource) *Gamepads {
+	return &Gamepads{Source: source, Deadzone: DefaultDeadzone}
+}
+
+// Update samples the gamepads for this tick and returns the ones that were
+// connected and disconnected since the previous tick.
+func (g *Gamepads) Update() (connected, disconnected []int) {
+	ids := append([]int(nil), g.Source.GamepadIDs()...)
+	sort.Ints(ids)
+	connected = difference(ids, g.connected)
+	disconnected = difference(g.connected, ids)
+	g.connected = ids
+
+	g.prev = g.cur
+	g.cur = 0
+	for _, id := range ids {
+		g.cur |= g.read(id)
+	}
+	return connected, disconnected
+}
+
+func (g *Gamepads) read(id int) Pad {
+	var p Pad
+	for _, b := range padButtons {
+		if g.Source.ButtonPressed(id, b.button) {
+			p |= b.pad
+		}
+	}
+	switch x := g.Source.Axis(id, AxisLeftX); {
+	case x < -g.Deadzone:
+		p |= PadLeft
+	case x > g.Deadzone:
+		p |= PadRight
+	}
+	switch y := g.Source.Axis(id, AxisLeftY); {
+	case y < -g.Deadzone:
+		p |= PadUp
+	case y > g.Deadzone:
+		p |= PadDown
+	}
+	return p
+}
+
+// Connected reports whether any gamepad is connected.
+func (g *Gamepads) Connected() bool {
+	return len(g.connected) > 0
+}
+
+// Held returns the buttons held this tick.
+func (g *Gamepads) Held() Pad {
+	return g.cur
+}
+
+// JustPressed returns the buttons pressed this tick.
+func (g *Gamepads) JustPressed() Pad {
+	return g.cur &^ g.prev
+}
+
+// AnyButtonJustPressed reports whether a button other than a direction was
+// pressed this tick.
+func (g *Gamepads) AnyButtonJustPressed() bool {
+	return g.JustPressed()&^padDirections != 0
+}
+
+// Actions returns the actions the held buttons stand for: the d-pad or
+// stick moves, A jumps, B and X fire, Start pauses and Back restarts.
+func (g *Gamepads) Actions() Set {
+	var s Set
+	p := g.cur
+	if p&PadLeft != 0 && p&PadRight == 0 {
+		s.Add(MoveLeft)
+	}
+	if p&PadRight != 0 && p&PadLeft == 0 {
+		s.Add(MoveRight)
+	}
+	if p&PadA != 0 {
+		s.Add(Jump)
+	}
+	if p&(PadB|PadX) != 0 {
+		s.Add(Fire)
+	}
+	if p&PadStart != 0 {
+		s.Add(Pause)
+	}
+	if p&PadBack != 0 {
+		s.Add(Restart)
+	}
+	return s
+}
+
+// difference returns the elements of the sorted slice a that are not in
+// the sorted slice b.
+func difference(a, b []int) []int {
+	var d []int
+	for _, x := range a {
+		i := sort.SearchInts(b, x)
+		if i == len(b) || b[i] != x {
+			d = append(d, x)
+		}
+	}
+	return d
+}
@@ -0,0 +1,115 @@
+package input
+
+import (
+	"reflect"
+	"testing"
+)
+
+// fakePad is a gamepad with the buttons and axes set by the test.
+type fakePad struct {
+	buttons map[int]bool
+	axes    map[int]float64
+}
+
+// fakeGamepads stands in for ebiten's gamepads.
+type fakeGamepads map[int]*fakePad
+
+func (f fakeGamepads) GamepadIDs() []int {
+	var ids []int
+	for id := range f {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (f fakeGamepads) Axis(id, axis int) float64 {
+	return f[id].axes[axis]
+}
+
+func (f fakeGamepads) ButtonPressed(id, button int) bool {
+	return f[id].buttons[button]
+}
+
+func newFakePad() *fakePad {
+	return &fakePad{buttons: map[int]bool{}, axes: map[int]float64{}}
+}
+
+func TestGamepadDeadzone(t *testing.T) {
+	for _, c := range []struct {
+		x, y float64
+		want Pad
+	}{
+		{0, 0, 0},
+		{0.2, -0.2, 0},
+		{-DefaultDeadzone, DefaultDeadzone, 0},
+		{0.5, 0, PadRight},
+		{-0.5, 0, PadLeft},
+		{0, -0.9, PadUp},
+		{0.3, 1, PadRight | PadDown},
+	} {
+		pad := newFakePad()
+		pad.axes[AxisLeftX], pad.axes[AxisLeftY] = c.x, c.y
+		g := NewGamepads(fakeGamepads{0: pad})
+		g.Update()
+		if got := g.Held(); got != c.want {
+			t.Errorf("stick at %v, %v: held %b, want %b", c.x, c.y, got, c.want)
+		}
+	}
+}
+
+func TestGamepadConnect(t *testing.T) {
+	source := fakeGamepads{}
+	g := NewGamepads(source)
+	if connected, disconnected := g.Update(); connected != nil || disconnected != nil || g.Connected() {
+		t.Errorf("no gamepads: connected %v, disconnected %v", connected, disconnected)
+	}
+
+	source[3], source[1] = newFakePad(), newFakePad()
+	if connected, disconnected := g.Update(); !reflect.DeepEqual(connected, []int{1, 3}) || disconnected != nil {
+		t.Errorf("plugged in 1 and 3: connected %v, disconnected %v", connected, disconnected)
+	}
+	if connected, disconnected := g.Update(); connected != nil || disconnected != nil {
+		t.Errorf("nothing changed: connected %v, disconnected %v", connected, disconnected)
+	}
+
+	source[1].buttons[ButtonA] = true
+	g.Update()
+	if g.Held() != PadA {
+		t.Errorf("held %b, want A", g.Held())
+	}
+	delete(source, 1)
+	if connected, disconnected := g.Update(); connected != nil || !reflect.DeepEqual(disconnected, []int{1}) {
+		t.Errorf("unplugged 1: connected %v, disconnected %v", connected, disconnected)
+	}
+	if !g.Connected() || g.Held() != 0 {
+		t.Errorf("after unplugging: connected %v, held %b, want true, 0", g.Connected(), g.Held())
+	}
+}
+
+func TestAnyButtonJustPressed(t *testing.T) {
+	a, b := newFakePad(), newFakePad()
+	g := NewGamepads(fakeGamepads{0: a, 1: b})
+	g.Update()
+
+	a.buttons[ButtonUp] = true
+	a.axes[AxisLeftX] = 1
+	g.Update()
+	if g.AnyButtonJustPressed() {
+		t.Error("directions count as buttons")
+	}
+
+	b.buttons[ButtonStart] = true
+	g.Update()
+	if !g.AnyButtonJustPressed() {
+		t.Error("Start on the second gamepad not noticed")
+	}
+	if g.Update(); g.AnyButtonJustPressed() {
+		t.Error("a held button is pressed again")
+	}
+
+	// the same button on another gamepad is still held, not pressed again
+	a.buttons[ButtonStart] = true
+	if g.Update(); g.AnyButtonJustPressed() {
+		t.Error("a button held on another gamepad is pressed again")
+	}
+}
@@ -0,0 +1,82 @@
+// Package input turns the devices the game is played with into named
+// actions. It knows nothing about ebiten: keys are referred to by the names
+// ebiten gives them, and the caller reports what is held each tick.
+package input
+
+// Action is something the player can do, independent of the key or button
+// that does it.
+type Action int
+
+const (
+	MoveLeft Action = iota
+	MoveRight
+	Jump
+	Fire
+	Restart
+	Pause
+
+	numActions
+)
+
+// Actions lists every action in the order they are shown.
+var Actions = []Action{MoveLeft, MoveRight, Jump, Fire, Restart, Pause}
+
+var actionNames = [numActions]string{
+	MoveLeft:  "move-left",
+	MoveRight: "move-right",
+	Jump:      "jump",
+	Fire:      "fire",
+	Restart:   "restart",
+	Pause:     "pause",
+}
+
+// String returns the name the action has in the config file.
+func (a Action) String() string {
+	if a < 0 || a >= numActions {
+		return "unknown"
+	}
+	return actionNames[a]
+}
+
+// ParseAction returns the action with the given config file name.
+func ParseAction(name string) (Action, bool) {
+	for a, n := range actionNames {
+		if n == name {
+			return Action(a), true
+		}
+	}
+	return 0, false
+}
+
+// Set is a set of actions.
+type Set uint32
+
+func (s Set) Has(a Action) bool {
+	return s&(1<<uint(a)) != 0
+}
+
+func (s *Set) Add(a Action) {
+	*s |= 1 << uint(a)
+}
+
+// Tracker remembers the actions held in the previous tick, so that the
+// moment an action starts can be told apart from it being held.
+type Tracker struct {
+	prev, cur Set
+}
+
+// Update records the actions held this tick. It is called once per tick,
+// before any of the other methods.
+func (t *Tracker) Update(held Set) {
+	t.prev, t.cur = t.cur, held
+}
+
+// Pressed reports whether a is held.
+func (t *Tracker) Pressed(a Action) bool {
+	return t.cur.Has(a)
+}
+
+// JustPressed reports whether a started being held this tick.
+func (t *Tracker) JustPressed(a Action) bool {
+	return t.cur.Has(a) && !t.prev.Has(a)
+}
@@ -0,0 +1,57 @@
+package input
+
+// Touch is one finger on the screen, in screen coordinates.
+type Touch struct {
+	ID   int
+	X, Y int
+}
+
+// TouchButton is an area of the screen that holds an action while touched.
+type TouchButton struct {
+	Action Action
+	Label  string
+	X, Y   int
+	W, H   int
+}
+
+func (b TouchButton) Contains(x, y int) bool {
+	return x >= b.X && x < b.X+b.W && y >= b.Y && y < b.Y+b.H
+}
+
+// TouchLayout is the set of on-screen buttons played with on a touch
+// screen.
+type TouchLayout struct {
+	Buttons []TouchButton
+}
+
+// DefaultTouchLayout puts a left/right pad in the bottom left corner of a
+// screen of the given size, jump and fire in the bottom right one and pause
+// at the top.
+func DefaultTouchLayout(width, height int) TouchLayout {
+	const (
+		size   = 80
+		margin = 16
+	)
+	y := height - size - margin
+	return TouchLayout{Buttons: []TouchButton{
+		{Action: MoveLeft, Label: "<", X: margin, Y: y, W: size, H: size},
+		{Action: MoveRight, Label: ">", X: 2*margin + size, Y: y, W: size, H: size},
+		{Action: Fire, Label: "FIRE", X: width - 2*size - 2*margin, Y: y, W: size, H: size},
+		{Action: Jump, Label: "JUMP", X: width - size - margin, Y: y, W: size, H: size},
+		{Action: Pause, Label: "II", X: (width - size/2) / 2, Y: margin / 2, W: size / 2, H: size / 2},
+	}}
+}
+
+// Actions returns the actions held by the touches. Every finger presses
+// the button it is on, so several buttons can be held at once.
+func (l TouchLayout) Actions(touches []Touch) Set {
+	var s Set
+	for _, t := range touches {
+		for _, b := range l.Buttons {
+			if b.Contains(t.X, t.Y) {
+				s.Add(b.Action)
+			}
+		}
+	}
+	return s
+}
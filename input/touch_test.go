@@ -0,0 +1,92 @@
+package input
+
+import "testing"
+
+const (
+	testScreenWidth  = 640
+	testScreenHeight = 480
+)
+
+// buttonTouch returns a touch in the middle of the layout's button for a.
+func buttonTouch(t *testing.T, l TouchLayout, id int, a Action) Touch {
+	for _, b := range l.Buttons {
+		if b.Action == a {
+			return Touch{ID: id, X: b.X + b.W/2, Y: b.Y + b.H/2}
+		}
+	}
+	t.Fatalf("no button for %v", a)
+	return Touch{}
+}
+
+func set(actions ...Action) Set {
+	var s Set
+	for _, a := range actions {
+		s.Add(a)
+	}
+	return s
+}
+
+func TestTouchActions(t *testing.T) {
+	l := DefaultTouchLayout(testScreenWidth, testScreenHeight)
+	for _, c := range []struct {
+		name    string
+		actions []Action
+		want    Set
+	}{
+		{"none", nil, 0},
+		{"one finger", []Action{Jump}, set(Jump)},
+		{"move, jump and fire", []Action{MoveRight, Jump, Fire}, set(MoveRight, Jump, Fire)},
+		{"both directions", []Action{MoveLeft, MoveRight}, set(MoveLeft, MoveRight)},
+		{"two fingers on one button", []Action{Fire, Fire}, set(Fire)},
+	} {
+		var touches []Touch
+		for i, a := range c.actions {
+			touches = append(touches, buttonTouch(t, l, i, a))
+		}
+		if got := l.Actions(touches); got != c.want {
+			t.Errorf("%s: actions %b, want %b", c.name, got, c.want)
+		}
+	}
+}
+
+func TestTouchOutsideButtons(t *testing.T) {
+	l := DefaultTouchLayout(testScreenWidth, testScreenHeight)
+	touches := []Touch{
+		{ID: 0, X: testScreenWidth / 2, Y: testScreenHeight / 2},
+		{ID: 1, X: 0, Y: 0},
+		{ID: 2, X: testScreenWidth - 1, Y: testScreenHeight - 1},
+		// between the left and right buttons
+		{ID: 3, X: 100, Y: testScreenHeight - 50},
+		{ID: 4, X: -10, Y: testScreenHeight + 10},
+	}
+	if got := l.Actions(touches); got != 0 {
+		t.Errorf("actions %b, want none", got)
+	}
+
+	touches = append(touches, buttonTouch(t, l, 5, MoveLeft))
+	if got := l.Actions(touches); got != set(MoveLeft) {
+		t.Errorf("with a finger on left: actions %b, want %b", got, set(MoveLeft))
+	}
+}
+
+func TestTouchPauseArea(t *testing.T) {
+	l := DefaultTouchLayout(testScreenWidth, testScreenHeight)
+	// the pause button is 40x40, centred at the top
+	for _, c := range []struct {
+		x, y  int
+		pause bool
+	}{
+		{300, 8, true},
+		{339, 47, true},
+		{320, 28, true},
+		{299, 8, false},
+		{340, 8, false},
+		{320, 7, false},
+		{320, 48, false},
+	} {
+		got := l.Actions([]Touch{{X: c.x, Y: c.y}})
+		if got.Has(Pause) != c.pause || got&^set(Pause) != 0 {
+			t.Errorf("touch at %d, %d: actions %b, want pause %v only", c.x, c.y, got, c.pause)
+		}
+	}
+}
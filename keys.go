@@ -0,0 +1,92 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/mariuseis/go-inn/input"
+)
+
+// keysByName looks keys up by the name ebiten gives them, the names used
+// in the key bindings.
+var keysByName = map[string]ebiten.Key{}
+
+func init() {
+	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+		keysByName[k.String()] = k
+	}
+}
+
+// modifiers are the keys that are held together with another key in a
+// chord. They are checked through ebiten's virtual keys, which stand for
+// both the left and the right one.
+var modifiers = []ebiten.Key{ebiten.KeyControl, ebiten.KeyShift, ebiten.KeyAlt, ebiten.KeyMeta}
+
+func knownKey(name string) bool {
+	_, ok := keysByName[name]
+	return ok || name == input.MouseLeft
+}
+
+func isModifier(k ebiten.Key) bool {
+	switch k {
+	case ebiten.KeyControl, ebiten.KeyControlLeft, ebiten.KeyControlRight,
+		ebiten.KeyShift, ebiten.KeyShiftLeft, ebiten.KeyShiftRight,
+		ebiten.KeyAlt, ebiten.KeyAltLeft, ebiten.KeyAltRight,
+		ebiten.KeyMeta, ebiten.KeyMetaLeft, ebiten.KeyMetaRight:
+		return true
+	}
+	return false
+}
+
+func isChordPressed(c input.Chord) bool {
+	keys := c.Keys()
+	if len(keys) == 0 {
+		return false
+	}
+	for _, name := range keys {
+		if name == input.MouseLeft {
+			if !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+				return false
+			}
+			continue
+		}
+		k, ok := keysByName[name]
+		if !ok || !ebiten.IsKeyPressed(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// keyboardActions returns the actions whose keys are held.
+func (g *Game) keyboardActions() input.Set {
+	var held input.Set
+	for _, a := range input.Actions {
+		for _, c := range g.bindings[a] {
+			if isChordPressed(c) {
+				held.Add(a)
+			}
+		}
+	}
+	return held
+}
+
+// justPressedChord returns the chord made of the modifiers held and the
+// key or mouse button pressed this tick, if any.
+func justPressedChord() (input.Chord, bool) {
+	var keys []string
+	for _, m := range modifiers {
+		if ebiten.IsKeyPressed(m) {
+			keys = append(keys, m.String())
+		}
+	}
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return input.NewChord(append(keys, input.MouseLeft)...), true
+	}
+	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+		if !isModifier(k) && inpututil.IsKeyJustPressed(k) {
+			return input.NewChord(append(keys, k.String())...), true
+		}
+	}
+	return "", false
+}
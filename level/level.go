@@ -0,0 +1,270 @@
+// Package level describes the layout of a stage: where the player starts,
+// its platforms, hazards, enemies, scenery and the inn that ends it. Levels
+// are stored as JSON so they can be built without touching Go code.
+package level
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Version is the level format version this build reads and writes.
+const Version = 1
+
+// TileSize is the width and height of one tile in pixels.
+const TileSize = 32
+
+//go:embed levels/*.json
+var builtin embed.FS
+
+//go:embed sequence.json
+var sequenceJSON []byte
+
+type Level struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+
+	PlayerStart Point `json:"playerStart"`
+
+	// Platforms can be stood on, touching a kill box ends the run.
+	Platforms []Strip `json:"platforms"`
+	KillBoxes []Strip `json:"killBoxes"`
+
+	// EnemySpawns places enemies at fixed positions, RandomEnemies adds
+	// a seeded random number of them on the ground.
+	EnemySpawns   []Point        `json:"enemySpawns,omitempty"`
+	RandomEnemies *RandomEnemies `json:"randomEnemies,omitempty"`
+
+	// Coins are placed with their top left corner at each point.
+	Coins []Point `json:"coins,omitempty"`
+
+	Decorations []Decoration `json:"decorations,omitempty"`
+
+	// Goal is the area covered by the inn. Reaching it in under ParTime
+	// seconds earns a time bonus; zero means the default par time.
+	Goal    Rect `json:"goal"`
+	ParTime int  `json:"parTime,omitempty"`
+
+	// Music names the track played in the level instead of the game's
+	// default one.
+	Music string `json:"music,omitempty"`
+
+	// Tileset, when set, is the image platform and kill box tiles are cut
+	// from. Without it the game's default tiles are used.
+	Tileset *Tileset `json:"tileset,omitempty"`
+}
+
+// Tileset is a grid of TileSize tiles in an image file. Tiles are numbered
+// from 0, left to right and then top to bottom.
+type Tileset struct {
+	Image   string `json:"image"`
+	Columns int    `json:"columns"`
+	Margin  int    `json:"margin,omitempty"`
+	Spacing int    `json:"spacing,omitempty"`
+}
+
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type Rect struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Strip is a horizontal row of Tiles tiles whose top left corner is at X, Y.
+// Tile picks the tileset tile drawn for each of them.
+type Strip struct {
+	X     int `json:"x"`
+	Y     int `json:"y"`
+	Tiles int `json:"tiles"`
+	Tile  int `json:"tile,omitempty"`
+}
+
+type RandomEnemies struct {
+	// Max is the largest number of enemies spawned, the actual count is
+	// drawn from [0, Max].
+	Max  int `json:"max"`
+	MinX int `json:"minX"`
+	MaxX int `json:"maxX"`
+}
+
+// Decoration is scenery without any effect on gameplay.
+type Decoration struct {
+	Image string `json:"image"`
+	X     int    `json:"x"`
+	Y     int    `json:"y"`
+}
+
+// ValidationError lists every problem found in a level, not just the first.
+type ValidationError struct {
+	Level    string
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("level %q: %s", e.Level, strings.Join(e.Problems, "; "))
+}
+
+// Validate checks the level for values the game cannot work with.
+func (l *Level) Validate() error {
+	var problems []string
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if l.Version != Version {
+		add("unsupported version %d (this build reads version %d)", l.Version, Version)
+	}
+	if l.Name == "" {
+		add("name is empty")
+	}
+	for i, s := range l.Platforms {
+		if s.Tiles < 1 {
+			add("platforms[%d]: tiles must be at least 1, got %d", i, s.Tiles)
+		}
+		if s.Tile < 0 {
+			add("platforms[%d]: tile must not be negative, got %d", i, s.Tile)
+		}
+	}
+	for i, s := range l.KillBoxes {
+		if s.Tiles < 1 {
+			add("killBoxes[%d]: tiles must be at least 1, got %d", i, s.Tiles)
+		}
+		if s.Tile < 0 {
+			add("killBoxes[%d]: tile must not be negative, got %d", i, s.Tile)
+		}
+	}
+	if r := l.RandomEnemies; r != nil {
+		if r.Max < 0 {
+			add("randomEnemies: max must not be negative, got %d", r.Max)
+		}
+		if r.MaxX <= r.MinX {
+			add("randomEnemies: maxX (%d) must be greater than minX (%d)", r.MaxX, r.MinX)
+		}
+	}
+	for i, d := range l.Decorations {
+		if d.Image == "" {
+			add("decorations[%d]: image is empty", i)
+		}
+	}
+	if l.ParTime < 0 {
+		add("parTime must not be negative, got %d", l.ParTime)
+	}
+	if l.Goal.Width <= 0 || l.Goal.Height <= 0 {
+		add("goal: width and height must be positive, got %dx%d", l.Goal.Width, l.Goal.Height)
+	}
+	if t := l.Tileset; t != nil {
+		if t.Image == "" {
+			add("tileset: image is empty")
+		}
+		if t.Columns < 1 {
+			add("tileset: columns must be at least 1, got %d", t.Columns)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Level: l.Name, Problems: problems}
+	}
+	return nil
+}
+
+// Read decodes and validates a level. source names the data in error
+// messages, usually the file name.
+func Read(r io.Reader, source string) (*Level, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	l := &Level{}
+	if err := dec.Decode(l); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line, col := position(data, syntaxErr.Offset)
+			return nil, fmt.Errorf("%s:%d:%d: %w", source, line, col, err)
+		}
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	if l.Name == "" {
+		l.Name = strings.TrimSuffix(filepath.Base(source), filepath.Ext(source))
+	}
+	if err := l.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	return l, nil
+}
+
+// LoadFile reads a level from disk. A relative tileset image path is taken
+// to be relative to the level file.
+func LoadFile(path string) (*Level, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	l, err := Read(f, path)
+	if err != nil {
+		return nil, err
+	}
+	if t := l.Tileset; t != nil && !filepath.IsAbs(t.Image) {
+		t.Image = filepath.Join(filepath.Dir(path), t.Image)
+	}
+	return l, nil
+}
+
+// Builtin reads one of the levels compiled into the binary by name, e.g.
+// "meadow".
+func Builtin(name string) (*Level, error) {
+	path := "levels/" + name + ".json"
+	f, err := builtin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("no built-in level %q", name)
+	}
+	defer f.Close()
+	return Read(f, path)
+}
+
+// TileRect returns the pixel bounds of tile t within the tileset image.
+func (t *Tileset) TileRect(tile int) (x0, y0, x1, y1 int) {
+	col := tile % t.Columns
+	row := tile / t.Columns
+	x0 = t.Margin + col*(TileSize+t.Spacing)
+	y0 = t.Margin + row*(TileSize+t.Spacing)
+	return x0, y0, x0 + TileSize, y0 + TileSize
+}
+
+// BuiltinSequence returns the names of the built-in levels in the order
+// they are played.
+func BuiltinSequence() ([]string, error) {
+	var seq struct {
+		Levels []string `json:"levels"`
+	}
+	if err := json.Unmarshal(sequenceJSON, &seq); err != nil {
+		return nil, fmt.Errorf("sequence.json: %w", err)
+	}
+	return seq.Levels, nil
+}
+
+// position converts a byte offset into a 1-based line and column.
+func position(data []byte, offset int64) (int, int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	col := int(offset) - bytes.LastIndexByte(before, '\n')
+	return line, col
+}
@@ -0,0 +1,218 @@
+package level
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// valid returns a level that passes Validate.
+func valid() *Level {
+	return &Level{
+		Version:       Version,
+		Name:          "test",
+		PlayerStart:   Point{X: 40, Y: 100},
+		Platforms:     []Strip{{X: 0, Y: 300, Tiles: 4}},
+		KillBoxes:     []Strip{{X: 200, Y: 448, Tiles: 2, Tile: 1}},
+		RandomEnemies: &RandomEnemies{Max: 2, MinX: 100, MaxX: 400},
+		Decorations:   []Decoration{{Image: "tree", X: 10, Y: 10}},
+		Goal:          Rect{X: 600, Y: 190, Width: 256, Height: 269},
+		ParTime:       30,
+		Tileset:       &Tileset{Image: "tiles.png", Columns: 4},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("valid level: %v", err)
+	}
+
+	for _, c := range []struct {
+		name    string
+		change  func(l *Level)
+		problem string
+	}{
+		{"version", func(l *Level) { l.Version = 2 }, "unsupported version 2 (this build reads version 1)"},
+		{"name", func(l *Level) { l.Name = "" }, "name is empty"},
+		{"platform tiles", func(l *Level) { l.Platforms = append(l.Platforms, Strip{Tiles: 0}) },
+			"platforms[1]: tiles must be at least 1, got 0"},
+		{"platform tile", func(l *Level) { l.Platforms[0].Tile = -1 }, "platforms[0]: tile must not be negative, got -1"},
+		{"kill box tiles", func(l *Level) { l.KillBoxes[0].Tiles = -2 }, "killBoxes[0]: tiles must be at least 1, got -2"},
+		{"kill box tile", func(l *Level) { l.KillBoxes[0].Tile = -3 }, "killBoxes[0]: tile must not be negative, got -3"},
+		{"random enemies max", func(l *Level) { l.RandomEnemies.Max = -1 }, "randomEnemies: max must not be negative, got -1"},
+		{"random enemies range", func(l *Level) { l.RandomEnemies.MaxX = 100 },
+			"randomEnemies: maxX (100) must be greater than minX (100)"},
+		{"decoration", func(l *Level) { l.Decorations[0].Image = "" }, "decorations[0]: image is empty"},
+		{"par time", func(l *Level) { l.ParTime = -5 }, "parTime must not be negative, got -5"},
+		{"goal width", func(l *Level) { l.Goal.Width = 0 }, "goal: width and height must be positive, got 0x269"},
+		{"goal height", func(l *Level) { l.Goal.Height = -1 }, "goal: width and height must be positive, got 256x-1"},
+		{"tileset image", func(l *Level) { l.Tileset.Image = "" }, "tileset: image is empty"},
+		{"tileset columns", func(l *Level) { l.Tileset.Columns = 0 }, "tileset: columns must be at least 1, got 0"},
+	} {
+		l := valid()
+		c.change(l)
+		err := l.Validate()
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("%s: error %v, want a ValidationError", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(verr.Problems, []string{c.problem}) {
+			t.Errorf("%s: problems %q, want %q", c.name, verr.Problems, c.problem)
+		}
+		if verr.Level != l.Name {
+			t.Errorf("%s: level %q, want %q", c.name, verr.Level, l.Name)
+		}
+	}
+}
+
+func TestValidateReportsAll(t *testing.T) {
+	l := valid()
+	l.Platforms = []Strip{{Tiles: 1}, {Tiles: 0}, {Tiles: 0, Tile: -1}}
+	l.Decorations[0].Image = ""
+	l.Goal = Rect{}
+
+	err := l.Validate()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("error %v, want a ValidationError", err)
+	}
+	want := []string{
+		"platforms[1]: tiles must be at least 1, got 0",
+		"platforms[2]: tiles must be at least 1, got 0",
+		"platforms[2]: tile must not be negative, got -1",
+		"decorations[0]: image is empty",
+		"goal: width and height must be positive, got 0x0",
+	}
+	if !reflect.DeepEqual(verr.Problems, want) {
+		t.Errorf("problems\n%q\nwant\n%q", verr.Problems, want)
+	}
+	if msg := err.Error(); msg != `level "test": `+strings.Join(want, "; ") {
+		t.Errorf("message %q", msg)
+	}
+}
+
+func TestRead(t *testing.T) {
+	l, err := Read(strings.NewReader(`{
+  "version": 1,
+  "playerStart": {"x": 40, "y": 100},
+  "platforms": [{"x": 0, "y": 300, "tiles": 4}],
+  "goal": {"x": 600, "y": 190, "width": 256, "height": 269}
+}`), "levels/cave.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the name defaults to the file name
+	if l.Name != "cave" {
+		t.Errorf("name %q, want cave", l.Name)
+	}
+	if want := []Strip{{X: 0, Y: 300, Tiles: 4}}; !reflect.DeepEqual(l.Platforms, want) {
+		t.Errorf("platforms %v, want %v", l.Platforms, want)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	for _, c := range []struct {
+		name, data string
+		want       string
+	}{
+		{"syntax", "{\n  \"version\": 1,\n  oops\n}", "bad.json:3:"},
+		{"unknown field", `{"version": 1, "platfroms": []}`, `bad.json: json: unknown field "platfroms"`},
+		{"wrong type", `{"version": "1"}`, "bad.json: json: cannot unmarshal string"},
+		{"invalid", `{"version": 1, "name": "x", "parTime": -1}`,
+			`bad.json: level "x": parTime must not be negative, got -1; goal: width and height must be positive, got 0x0`},
+	} {
+		_, err := Read(strings.NewReader(c.data), "bad.json")
+		if err == nil || !strings.HasPrefix(err.Error(), c.want) {
+			t.Errorf("%s: error %v, want it to start with %q", c.name, err, c.want)
+		}
+	}
+
+	// the validation error can still be told apart
+	_, err := Read(strings.NewReader(`{"version": 2, "name": "x", "goal": {"width": 1, "height": 1}}`), "bad.json")
+	var verr *ValidationError
+	if !errors.As(err, &verr) || len(verr.Problems) != 1 {
+		t.Errorf("error %v, want a ValidationError with one problem", err)
+	}
+}
+
+func TestPosition(t *testing.T) {
+	data := []byte("ab\ncde\n\nf")
+	for _, c := range []struct {
+		offset    int64
+		line, col int
+	}{
+		{0, 1, 1},
+		{2, 1, 3},
+		{3, 2, 1},
+		{5, 2, 3},
+		{7, 3, 1},
+		{100, 4, 2},
+	} {
+		if line, col := position(data, c.offset); line != c.line || col != c.col {
+			t.Errorf("offset %d: %d:%d, want %d:%d", c.offset, line, col, c.line, c.col)
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cave.json")
+	data := `{"version": 1, "goal": {"width": 1, "height": 1}, "tileset": {"image": "art/tiles.png", "columns": 2}}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	l, err := LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "art", "tiles.png"); l.Tileset.Image != want {
+		t.Errorf("tileset image %q, want %q", l.Tileset.Image, want)
+	}
+	if _, err := LoadFile(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing file: %v", err)
+	}
+}
+
+func TestBuiltin(t *testing.T) {
+	names, err := BuiltinSequence()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) == 0 {
+		t.Fatal("no built-in levels")
+	}
+	for _, name := range names {
+		l, err := Builtin(name)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if l.Name != name {
+			t.Errorf("%s: name %q", name, l.Name)
+		}
+	}
+	if _, err := Builtin("nowhere"); err == nil {
+		t.Error("no error for an unknown level")
+	}
+}
+
+func TestTileRect(t *testing.T) {
+	ts := &Tileset{Columns: 3, Margin: 1, Spacing: 2}
+	for _, c := range []struct {
+		tile   int
+		x0, y0 int
+	}{
+		{0, 1, 1},
+		{2, 1 + 2*34, 1},
+		{4, 1 + 34, 1 + 34},
+	} {
+		x0, y0, x1, y1 := ts.TileRect(c.tile)
+		if x0 != c.x0 || y0 != c.y0 || x1-x0 != TileSize || y1-y0 != TileSize {
+			t.Errorf("tile %d: %d,%d-%d,%d, want %d,%d", c.tile, x0, y0, x1, y1, c.x0, c.y0)
+		}
+	}
+}
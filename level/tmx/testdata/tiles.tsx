@@ -0,0 +1,4 @@
+<?xml version="1.0" encoding="UTF-8"?>
+<tileset version="1.10" name="tiles" tilewidth="32" tileheight="32" tilecount="8" columns="4" margin="1" spacing="2">
+ <image source="tiles.png" width="134" height="67"/>
+</tileset>
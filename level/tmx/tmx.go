@@ -0,0 +1,381 @@
+// Package tmx imports maps made with the Tiled editor (https://www.mapeditor.org)
+// and converts them into levels.
+//
+// Tile layers become platforms, or kill boxes when the layer has a "kind"
+// property set to "killbox". Objects are recognised by their type (or class
+// in newer Tiled versions):
+//
+//	player     where the player starts
+//	enemy      an enemy spawn
+//	enemies    a random number of enemies on the ground within the object's
+//	           width; the "max" property caps the count
+//	coin       a coin
+//	killbox    a row of kill box tiles; the "tile" property picks the tile
+//	goal       the inn
+//	decoration scenery; the "image" property names the image
+//
+// The map's "name" property names the level, "parTime" sets its par time in
+// seconds and "music" picks its music track. Only orthogonal, finite maps
+// with a single tileset of 32x32 tiles are supported.
+package tmx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mariuseis/go-inn/level"
+)
+
+// Tiled stores flip and rotation flags in the top bits of a gid.
+const gidMask = 0x1fffffff
+
+type tmxMap struct {
+	Orientation string        `xml:"orientation,attr"`
+	Width       int           `xml:"width,attr"`
+	Height      int           `xml:"height,attr"`
+	TileWidth   int           `xml:"tilewidth,attr"`
+	TileHeight  int           `xml:"tileheight,attr"`
+	Infinite    int           `xml:"infinite,attr"`
+	Properties  []property    `xml:"properties>property"`
+	Tilesets    []tileset     `xml:"tileset"`
+	Layers      []layer       `xml:"layer"`
+	ObjectGroup []objectGroup `xml:"objectgroup"`
+}
+
+type property struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type tileset struct {
+	FirstGID   int    `xml:"firstgid,attr"`
+	Source     string `xml:"source,attr"`
+	TileWidth  int    `xml:"tilewidth,attr"`
+	TileHeight int    `xml:"tileheight,attr"`
+	Columns    int    `xml:"columns,attr"`
+	Margin     int    `xml:"margin,attr"`
+	Spacing    int    `xml:"spacing,attr"`
+	Image      struct {
+		Source string `xml:"source,attr"`
+	} `xml:"image"`
+}
+
+type layer struct {
+	Name       string     `xml:"name,attr"`
+	Width      int        `xml:"width,attr"`
+	Height     int        `xml:"height,attr"`
+	Properties []property `xml:"properties>property"`
+	Data       struct {
+		Encoding    string `xml:"encoding,attr"`
+		Compression string `xml:"compression,attr"`
+		Tiles       []struct {
+			GID uint32 `xml:"gid,attr"`
+		} `xml:"tile"`
+		Text string `xml:",chardata"`
+	} `xml:"data"`
+}
+
+type objectGroup struct {
+	Name    string   `xml:"name,attr"`
+	Objects []object `xml:"object"`
+}
+
+type object struct {
+	ID         int        `xml:"id,attr"`
+	Name       string     `xml:"name,attr"`
+	Type       string     `xml:"type,attr"`
+	Class      string     `xml:"class,attr"`
+	GID        uint32     `xml:"gid,attr"`
+	X          float64    `xml:"x,attr"`
+	Y          float64    `xml:"y,attr"`
+	Width      float64    `xml:"width,attr"`
+	Height     float64    `xml:"height,attr"`
+	Properties []property `xml:"properties>property"`
+}
+
+func lookup(props []property, name string) (string, bool) {
+	for _, p := range props {
+		if p.Name == name {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// LoadFile imports the TMX map at path. Tilesets and images it refers to
+// are resolved relative to the file that names them.
+func LoadFile(path string) (*level.Level, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m tmxMap
+	if err := xml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	l, err := convert(&m, filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if l.Name == "" {
+		l.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if err := l.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return l, nil
+}
+
+func convert(m *tmxMap, dir string) (*level.Level, error) {
+	if m.Orientation != "" && m.Orientation != "orthogonal" {
+		return nil, fmt.Errorf("unsupported orientation %q", m.Orientation)
+	}
+	if m.Infinite != 0 {
+		return nil, fmt.Errorf("infinite maps are not supported")
+	}
+	if m.TileWidth != level.TileSize || m.TileHeight != level.TileSize {
+		return nil, fmt.Errorf("tiles must be %dx%d, got %dx%d", level.TileSize, level.TileSize, m.TileWidth, m.TileHeight)
+	}
+
+	l := &level.Level{Version: level.Version}
+	l.Name, _ = lookup(m.Properties, "name")
+	l.Music, _ = lookup(m.Properties, "music")
+	if v, ok := lookup(m.Properties, "parTime"); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("map property \"parTime\": %w", err)
+		}
+		l.ParTime = n
+	}
+
+	firstGID := 1
+	switch len(m.Tilesets) {
+	case 0:
+	case 1:
+		ts, err := loadTileset(m.Tilesets[0], dir)
+		if err != nil {
+			return nil, err
+		}
+		firstGID = m.Tilesets[0].FirstGID
+		l.Tileset = ts
+	default:
+		return nil, fmt.Errorf("only one tileset per map is supported, got %d", len(m.Tilesets))
+	}
+
+	for _, ly := range m.Layers {
+		gids, err := decodeLayer(ly)
+		if err != nil {
+			return nil, fmt.Errorf("layer %q: %w", ly.Name, err)
+		}
+		strips := stripsFromGIDs(gids, ly.Width, firstGID)
+
+		kind, _ := lookup(ly.Properties, "kind")
+		switch kind {
+		case "", "platform":
+			l.Platforms = append(l.Platforms, strips...)
+		case "killbox":
+			l.KillBoxes = append(l.KillBoxes, strips...)
+		default:
+			return nil, fmt.Errorf("layer %q: unknown kind %q", ly.Name, kind)
+		}
+	}
+
+	goalFound := false
+	for _, g := range m.ObjectGroup {
+		for _, o := range g.Objects {
+			if err := addObject(l, o, firstGID); err != nil {
+				return nil, fmt.Errorf("object layer %q: object %d: %w", g.Name, o.ID, err)
+			}
+			if objectType(o) == "goal" {
+				goalFound = true
+			}
+		}
+	}
+	if !goalFound {
+		return nil, fmt.Errorf("no object of type \"goal\"")
+	}
+
+	return l, nil
+}
+
+func objectType(o object) string {
+	if o.Type != "" {
+		return o.Type
+	}
+	return o.Class
+}
+
+func addObject(l *level.Level, o object, firstGID int) error {
+	x, y := int(o.X), int(o.Y)
+	w, h := int(o.Width), int(o.Height)
+	// Tile objects are anchored at their bottom left corner.
+	if o.GID != 0 {
+		y -= h
+	}
+
+	switch t := objectType(o); t {
+	case "player":
+		l.PlayerStart = level.Point{X: x, Y: y}
+	case "enemy":
+		l.EnemySpawns = append(l.EnemySpawns, level.Point{X: x, Y: y})
+	case "coin":
+		l.Coins = append(l.Coins, level.Point{X: x, Y: y})
+	case "enemies":
+		max := 1
+		if v, ok := lookup(o.Properties, "max"); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("property \"max\": %w", err)
+			}
+			max = n
+		}
+		l.RandomEnemies = &level.RandomEnemies{Max: max, MinX: x, MaxX: x + w}
+	case "killbox":
+		tile := 0
+		if o.GID != 0 {
+			tile = int(o.GID&gidMask) - firstGID
+		} else if v, ok := lookup(o.Properties, "tile"); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("property \"tile\": %w", err)
+			}
+			tile = n
+		}
+		if w%level.TileSize != 0 {
+			return fmt.Errorf("kill box width %d is not a multiple of %d", w, level.TileSize)
+		}
+		l.KillBoxes = append(l.KillBoxes, level.Strip{X: x, Y: y, Tiles: w / level.TileSize, Tile: tile})
+	case "goal":
+		l.Goal = level.Rect{X: x, Y: y, Width: w, Height: h}
+	case "decoration":
+		image, ok := lookup(o.Properties, "image")
+		if !ok {
+			return fmt.Errorf("decoration without an \"image\" property")
+		}
+		l.Decorations = append(l.Decorations, level.Decoration{Image: image, X: x, Y: y})
+	default:
+		return fmt.Errorf("unknown type %q", t)
+	}
+	return nil
+}
+
+func loadTileset(ts tileset, dir string) (*level.Tileset, error) {
+	if ts.Source != "" {
+		path := filepath.Join(dir, ts.Source)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		firstGID := ts.FirstGID
+		if err := xml.Unmarshal(data, &ts); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		ts.FirstGID = firstGID
+		dir = filepath.Dir(path)
+	}
+
+	if ts.TileWidth != level.TileSize || ts.TileHeight != level.TileSize {
+		return nil, fmt.Errorf("tileset tiles must be %dx%d, got %dx%d", level.TileSize, level.TileSize, ts.TileWidth, ts.TileHeight)
+	}
+	if ts.Image.Source == "" {
+		return nil, fmt.Errorf("tileset has no image; image collection tilesets are not supported")
+	}
+	return &level.Tileset{
+		Image:   filepath.Join(dir, ts.Image.Source),
+		Columns: ts.Columns,
+		Margin:  ts.Margin,
+		Spacing: ts.Spacing,
+	}, nil
+}
+
+// decodeLayer returns the layer's gids, row by row, with the flip flags
+// cleared.
+func decodeLayer(ly layer) ([]uint32, error) {
+	var gids []uint32
+	switch ly.Data.Encoding {
+	case "":
+		for _, t := range ly.Data.Tiles {
+			gids = append(gids, t.GID)
+		}
+	case "csv":
+		for _, f := range strings.Split(ly.Data.Text, ",") {
+			f = strings.TrimSpace(f)
+			if f == "" {
+				continue
+			}
+			n, err := strconv.ParseUint(f, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			gids = append(gids, uint32(n))
+		}
+	case "base64":
+		raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(ly.Data.Text))
+		if err != nil {
+			return nil, err
+		}
+		var r io.Reader = bytes.NewReader(raw)
+		switch ly.Data.Compression {
+		case "":
+		case "zlib":
+			if r, err = zlib.NewReader(r); err != nil {
+				return nil, err
+			}
+		case "gzip":
+			if r, err = gzip.NewReader(r); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported compression %q", ly.Data.Compression)
+		}
+		if raw, err = io.ReadAll(r); err != nil {
+			return nil, err
+		}
+		for i := 0; i+4 <= len(raw); i += 4 {
+			gids = append(gids, binary.LittleEndian.Uint32(raw[i:]))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", ly.Data.Encoding)
+	}
+
+	if len(gids) != ly.Width*ly.Height {
+		return nil, fmt.Errorf("has %d tiles, want %dx%d", len(gids), ly.Width, ly.Height)
+	}
+	for i := range gids {
+		gids[i] &= gidMask
+	}
+	return gids, nil
+}
+
+// stripsFromGIDs merges horizontal runs of the same tile into strips.
+func stripsFromGIDs(gids []uint32, width int, firstGID int) []level.Strip {
+	var strips []level.Strip
+	for i := 0; i < len(gids); {
+		gid := gids[i]
+		start := i
+		for i++; i < len(gids) && i%width != 0 && gids[i] == gid; i++ {
+		}
+		if gid == 0 {
+			continue
+		}
+		strips = append(strips, level.Strip{
+			X:     (start % width) * level.TileSize,
+			Y:     (start / width) * level.TileSize,
+			Tiles: i - start,
+			Tile:  int(gid) - firstGID,
+		})
+	}
+	return strips
+}
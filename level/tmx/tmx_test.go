@@ -0,0 +1,94 @@
+package tmx
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mariuseis/go-inn/level"
+)
+
+func TestLayerEncodings(t *testing.T) {
+	// Every map has the same 4x2 layer, one of the tiles flipped, and the
+	// external tileset tiles.tsx.
+	want := []level.Strip{
+		{X: 0, Y: 0, Tiles: 2, Tile: 0},
+		{X: 96, Y: 0, Tiles: 1, Tile: 1},
+		{X: 32, Y: 32, Tiles: 2, Tile: 2},
+	}
+	wantTileset := &level.Tileset{
+		Image:   filepath.Join("testdata", "tiles.png"),
+		Columns: 4,
+		Margin:  1,
+		Spacing: 2,
+	}
+
+	for _, name := range []string{"xml", "csv", "base64", "zlib", "gzip"} {
+		l, err := LoadFile(filepath.Join("testdata", name+".tmx"))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if l.Name != name {
+			t.Errorf("%s: name %q", name, l.Name)
+		}
+		if !reflect.DeepEqual(l.Platforms, want) {
+			t.Errorf("%s: platforms %+v, want %+v", name, l.Platforms, want)
+		}
+		if !reflect.DeepEqual(l.Tileset, wantTileset) {
+			t.Errorf("%s: tileset %+v, want %+v", name, l.Tileset, wantTileset)
+		}
+	}
+}
+
+func TestObjects(t *testing.T) {
+	l, err := LoadFile(filepath.Join("testdata", "objects.tmx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &level.Level{
+		Version:     level.Version,
+		Name:        "objects",
+		PlayerStart: level.Point{X: 10, Y: 20},
+		KillBoxes: []level.Strip{
+			// the layer
+			{X: 0, Y: 32, Tiles: 2, Tile: 2},
+			// the objects, one with a tile property and one a flipped
+			// tile object
+			{X: 640, Y: 400, Tiles: 2, Tile: 2},
+			{X: 704, Y: 400, Tiles: 1, Tile: 3},
+		},
+		EnemySpawns:   []level.Point{{X: 300, Y: 40}},
+		RandomEnemies: &level.RandomEnemies{Max: 3, MinX: 400, MaxX: 600},
+		// the second coin is a tile object, anchored at its bottom
+		Coins:       []level.Point{{X: 50, Y: 60}, {X: 70, Y: 84}},
+		Decorations: []level.Decoration{{Image: "tree", X: 120, Y: 130}},
+		Goal:        level.Rect{X: 900, Y: 200, Width: 256, Height: 269},
+		ParTime:     45,
+		Music:       "ragtime",
+		Tileset:     &level.Tileset{Image: filepath.Join("testdata", "art", "tiles.png"), Columns: 4},
+	}
+	if !reflect.DeepEqual(l, want) {
+		t.Errorf("got  %+v\nwant %+v", l, want)
+	}
+}
+
+func TestDecodeLayerErrors(t *testing.T) {
+	for _, c := range []struct {
+		name                  string
+		encoding, compression string
+		width, height         int
+	}{
+		{"unknown encoding", "base32", "", 1, 1},
+		{"unknown compression", "base64", "zstd", 1, 1},
+		{"wrong size", "base64", "", 2, 2},
+	} {
+		ly := layer{Width: c.width, Height: c.height}
+		// a single gid 1
+		ly.Data.Encoding, ly.Data.Compression, ly.Data.Text = c.encoding, c.compression, "AQAAAA=="
+		if _, err := decodeLayer(ly); err == nil {
+			t.Errorf("%s: no error", c.name)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/mariuseis/go-inn/level"
+	"github.com/mariuseis/go-inn/level/tmx"
+)
+
+// tilesetImages holds the tileset images of loaded levels by path.
+var tilesetImages = map[string]*ebiten.Image{}
+
+// loadLevels loads the comma separated level files in paths, or the
+// built-in levels when paths is empty. Every level is loaded, the error
+// lists all that failed.
+func loadLevels(paths string) ([]*level.Level, error) {
+	var sources []string
+	if paths != "" {
+		sources = strings.Split(paths, ",")
+	} else {
+		names, err := level.BuiltinSequence()
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			sources = append(sources, "builtin:"+name)
+		}
+	}
+
+	var levels []*level.Level
+	var problems []string
+	for _, src := range sources {
+		l, err := loadLevel(src)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		levels = append(levels, l)
+	}
+	if len(problems) > 0 {
+		return nil, errors.New(strings.Join(problems, "\n"))
+	}
+	if len(levels) == 0 {
+		return nil, errors.New("no levels to play")
+	}
+	return levels, nil
+}
+
+// loadLevel reads a level from a JSON or TMX file, or a built-in level
+// named "builtin:<name>", and loads the images it needs.
+func loadLevel(path string) (*level.Level, error) {
+	var l *level.Level
+	var err error
+	switch {
+	case strings.HasPrefix(path, "builtin:"):
+		l, err = level.Builtin(strings.TrimPrefix(path, "builtin:"))
+	case strings.EqualFold(filepath.Ext(path), ".tmx"):
+		l, err = tmx.LoadFile(path)
+	default:
+		l, err = level.LoadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkReferences(l); err != nil {
+		return nil, err
+	}
+	if ts := l.Tileset; ts != nil && tilesetImages[ts.Image] == nil {
+		img, _, err := ebitenutil.NewImageFromFile(ts.Image)
+		if err != nil {
+			return nil, fmt.Errorf("level %q: tileset: %w", l.Name, err)
+		}
+		tilesetImages[ts.Image] = img
+	}
+	return l, nil
+}
+
+// checkReferences reports decorations that name an image, and music that
+// names a track, the game does not have.
+func checkReferences(l *level.Level) error {
+	var problems []string
+	for i, d := range l.Decorations {
+		if assetImage(d.Image) == nil {
+			problems = append(problems, fmt.Sprintf("decorations[%d]: unknown image %q", i, d.Image))
+		}
+	}
+	switch l.Music {
+	case "", musicTitle, musicGameOver:
+	default:
+		if _, s, ok := catalog.Sound(l.Music); !ok || !s.Music {
+			problems = append(problems, fmt.Sprintf("music: unknown track %q", l.Music))
+		}
+	}
+	if len(problems) > 0 {
+		return &level.ValidationError{Level: l.Name, Problems: problems}
+	}
+	return nil
+}
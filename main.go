@@ -1,39 +1,36 @@
 package main
 
 import (
-	"bytes"
+	"flag"
 	"fmt"
 	"image"
 	"image/color"
 	_ "image/png"
 	"log"
 	"math"
-	"math/rand"
+	"os"
 	"time"
 
 	"golang.org/x/image/font"
-	"golang.org/x/image/font/opentype"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
-	"github.com/hajimehoshi/ebiten/v2/examples/resources/fonts"
-
-	"github.com/hajimehoshi/ebiten/v2/audio"
-	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
-	"github.com/hajimehoshi/ebiten/v2/audio/wav"
-	raudio "github.com/hajimehoshi/ebiten/v2/examples/resources/audio"
-
-	resources "github.com/hajimehoshi/ebiten/v2/examples/resources/images/flappy"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text"
 
+	"github.com/mariuseis/go-inn/anim"
+	"github.com/mariuseis/go-inn/assets"
+	"github.com/mariuseis/go-inn/highscore"
 	"github.com/mariuseis/go-inn/images"
+	"github.com/mariuseis/go-inn/input"
+	"github.com/mariuseis/go-inn/level"
+	"github.com/mariuseis/go-inn/persist"
+	"github.com/mariuseis/go-inn/replay"
+	"github.com/mariuseis/go-inn/save"
+	"github.com/mariuseis/go-inn/settings"
+	"github.com/mariuseis/go-inn/sound"
+	"github.com/mariuseis/go-inn/world"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
-
 func floorDiv(x, y int) int {
 	d := x / y
 	if d*y == x || x >= 0 {
@@ -47,111 +44,36 @@ func floorMod(x, y int) int {
 }
 
 const (
-	screenWidth      = 640
-	screenHeight     = 480
-	tileSize         = 32
-	titleFontSize    = fontSize * 1.5
-	fontSize         = 24
-	smallFontSize    = fontSize / 2
-	pipeWidth        = tileSize * 2
-	pipeStartOffsetX = -1
-	pipeIntervalX    = 8
-	pipeGapY         = 5
-	projectileSpeed  = 5
-
-	maxMoveVelocity     = 3
-	moveAcceleration    = 1
-	gravityAcceleration = 1
-	maxGravityVelocity  = 8
-	jumpVelocity        = 8
+	screenWidth   = world.ScreenWidth
+	screenHeight  = world.ScreenHeight
+	tileSize      = world.TileSize
+	titleFontSize = fontSize * 1.5
+	fontSize      = 24
+	smallFontSize = fontSize / 2
 )
 
 var (
-	gopherImage     *ebiten.Image
-	enemyImage      *ebiten.Image
-	tilesImage      *ebiten.Image
-	bulletImage     *ebiten.Image
-	treeImage       *ebiten.Image
-	innImage        *ebiten.Image
 	titleArcadeFont font.Face
 	arcadeFont      font.Face
 	smallArcadeFont font.Face
 )
 
-//asset image declarations
-func init() {
-	// 1. create const "img" and use Gopher_png from resources
-	img, _, err := image.Decode(bytes.NewReader(images.Player_png))
-	// 2. handle image error
-	if err != nil {
-		log.Fatal(err)
-	}
-	// 3. declare the gopherImage and use the "img" defined above
-	gopherImage = ebiten.NewImageFromImage(img)
-
-	// All 3 main steps are repeated for other images, in this case -> floor tiles
-	img, _, err = image.Decode(bytes.NewReader(resources.Tiles_png))
-	if err != nil {
-		log.Fatal(err)
-	}
-	tilesImage = ebiten.NewImageFromImage(img)
-
-	img, _, err = image.Decode(bytes.NewReader(images.Enemy_png))
-	if err != nil {
-		log.Fatal(err)
-	}
-	enemyImage = ebiten.NewImageFromImage(img)
-
-	img, _, err = image.Decode(bytes.NewReader(images.Bullet_png))
-	if err != nil {
-		log.Fatal(err)
-	}
-	bulletImage = ebiten.NewImageFromImage(img)
-
-	img, _, err = image.Decode(bytes.NewReader(images.Tree_png))
-	if err != nil {
-		log.Fatal(err)
-	}
-	treeImage = ebiten.NewImageFromImage(img)
-
-	img, _, err = image.Decode(bytes.NewReader(images.Inn_png))
-	if err != nil {
-		log.Fatal(err)
-	}
-	innImage = ebiten.NewImageFromImage(img)
-}
-
-//text font declarations
-func init() {
-	tt, err := opentype.Parse(fonts.PressStart2P_ttf)
-	if err != nil {
-		log.Fatal(err)
-	}
-	const dpi = 72
-	titleArcadeFont, err = opentype.NewFace(tt, &opentype.FaceOptions{
-		Size:    titleFontSize,
-		DPI:     dpi,
-		Hinting: font.HintingFull,
-	})
-	if err != nil {
-		log.Fatal(err)
-	}
-	arcadeFont, err = opentype.NewFace(tt, &opentype.FaceOptions{
-		Size:    fontSize,
-		DPI:     dpi,
-		Hinting: font.HintingFull,
-	})
-	if err != nil {
-		log.Fatal(err)
-	}
-	smallArcadeFont, err = opentype.NewFace(tt, &opentype.FaceOptions{
-		Size:    smallFontSize,
-		DPI:     dpi,
-		Hinting: font.HintingFull,
-	})
-	if err != nil {
-		log.Fatal(err)
+// drawCoin draws a gold coin with a darker rim, size pixels across.
+func drawCoin(size int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	c := float64(size-1) / 2
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			d := math.Hypot(float64(x)-c, float64(y)-c)
+			switch {
+			case d <= c-2:
+				img.Set(x, y, color.RGBA{0xff, 0xd7, 0x00, 0xff})
+			case d <= c:
+				img.Set(x, y, color.RGBA{0xb8, 0x86, 0x0b, 0xff})
+			}
+		}
 	}
+	return img
 }
 
 type Mode int
@@ -160,197 +82,251 @@ const (
 	ModeTitle Mode = iota
 	ModeGame
 	ModeGameOver
+	ModeLevelComplete
+	ModeInn
+	ModeEnding
+	ModeNameEntry
+	ModeControls
+	ModePause
+	ModeOptions
 )
 
-type BaseCollider struct {
-	x int
-	y int
-}
-
-type Collidable struct {
-	baseCollider BaseCollider
-	width        int
-	height       int
-}
-
-type Platform struct {
-	baseCollider BaseCollider
-	tileCount    int
-}
-
-type Enemy struct {
-	baseCollider BaseCollider
-	vx           int
-	isMovingLeft bool
-}
-type Projectile struct {
-	lifespan     int
-	isMovingLeft bool
-	baseCollider BaseCollider
+// Options are the command line settings a Game is started with.
+type Options struct {
+	// Levels are played in order, the run ends after the last one.
+	Levels []*level.Level
+	// Seed, when non-zero, is used for every run instead of a fresh
+	// time-based seed.
+	Seed int64
+	// RecordPath is where the inputs of the last finished level are
+	// written.
+	RecordPath string
+	// Playback drives the game from a recording instead of the keyboard.
+	Playback *replay.Replay
+	// HighScorePath is where the high-score table is kept. When empty
+	// the table lasts only as long as the process.
+	HighScorePath string
+	// SavePath is where an unfinished run is saved when the game is
+	// closed. When empty runs are not saved.
+	SavePath string
+	// ControlsPath is where the key bindings are kept. When empty the
+	// defaults are used and changes are not saved.
+	ControlsPath string
+	// AssetDir, when set, is the directory the assets were loaded from.
+	// Images are reloaded from it when their files change.
+	AssetDir string
+	// Settings are the audio and video options, kept at SettingsPath.
+	Settings     settings.Settings
+	SettingsPath string
 }
 
 type Game struct {
 	mode Mode
 
-	// The gopher's position
-	x16  int
-	y16  int
-	vy16 int
-	vx16 int
-
-	movingLeft bool
-
-	// Camera
-	cameraX int
-	cameraY int
-
-	enemies     []Enemy
-	projectiles []Projectile
+	world   *world.World
+	options Options
+
+	// The run: its seed, the index of the current level in options.Levels
+	// and the score of the levels completed so far.
+	seed       int64
+	levelIndex int
+	runScore   world.Score
+
+	// Coins in the purse and the upgrades bought with them, both kept
+	// from level to level.
+	coins    int
+	upgrades world.Upgrades
+
+	shopMenu    *menu
+	shopMessage string
+
+	recording *replay.Replay
+	playback  *replay.Player
+
+	// The high-score table, the rank this run's entry got in it or -1,
+	// and the name being typed for it. nameEntryTicks counts the ticks
+	// spent typing, for the cursor to blink.
+	highScores     *highscore.Table
+	highScoreRank  int
+	playerName     string
+	nameEntryTicks int
+	afterNameEntry Mode
+
+	// saved is the run that can be continued from the title screen.
+	saved     *save.Save
+	titleMenu *menu
+	pauseMenu *menu
+
+	settings    settings.Settings
+	optionsMenu *menu
+	optionsBack Mode
+
+	bindings       input.Bindings
+	controls       input.Tracker
+	controlsScreen *controlsScreen
+
+	pads                *input.Gamepads
+	gamepadMessage      string
+	gamepadMessageTicks int
+
+	touchLayout input.TouchLayout
+	// touchScreen is set once the screen has been touched, from then on
+	// the on-screen buttons are drawn.
+	touchScreen bool
 
 	gameoverCount int
-	jumpCount     int
 
-	audioContext *audio.Context
-	jumpPlayer   *audio.Player
-	hitPlayer    *audio.Player
+	sound *sound.Manager
 
-	platforms []Platform
-	killBoxes []Platform
+	// The animations of the player and the enemies of animWorld, and of
+	// the enemies killed whose death is still playing.
+	animWorld  *world.World
+	playerAnim *anim.Machine
+	enemyAnims map[world.ID]*enemyAnim
+	corpses    []*enemyAnim
+
+	assetWatcher *assetWatcher
 }
 
-func NewGame() *Game {
-	g := &Game{}
+func NewGame(options Options) *Game {
+	g := &Game{options: options, settings: options.Settings}
+	g.sound = sound.New()
+	if err := loadSounds(g.sound); err != nil {
+		log.Fatal(err)
+	}
+	loadMusic(g.sound)
+	g.applyVolumes()
+	if options.AssetDir != "" {
+		g.assetWatcher = newAssetWatcher(options.AssetDir)
+	}
+	g.highScores = loadHighScores(options.HighScorePath)
+	if options.Playback == nil {
+		g.saved = loadSave(options.SavePath)
+	}
+	g.titleMenu = &menu{}
+	g.bindings = loadBindings(options.ControlsPath)
+	g.pads = input.NewGamepads(ebitenGamepads{})
+	g.touchLayout = input.DefaultTouchLayout(screenWidth, screenHeight)
+	if options.Playback != nil {
+		g.playback = replay.NewPlayer(options.Playback)
+	}
 	g.init()
 	return g
 }
 
-func createEnemy() Enemy {
-	gopherHeight := 60
-	groundPositionY := screenHeight - gopherHeight - tileSize
-	enemy := Enemy{baseCollider: BaseCollider{x: rand.Intn(screenWidth), y: groundPositionY}, vx: 2}
-	return enemy
-}
-
 func (g *Game) init() {
-	g.x16 = 0
-	g.y16 = 100
-	g.cameraX = -240
-	g.cameraY = 0
-	g.jumpCount = 0
-
-	enemyCount := rand.Intn(8)
-	var enemies []Enemy
-
-	for i := 1; i <= enemyCount; i++ {
-		enemy := createEnemy()
-		enemies = append(enemies, enemy)
+	g.seed = g.options.Seed
+	g.levelIndex = 0
+	g.upgrades = world.Upgrades{}
+	if g.playback != nil {
+		g.seed = g.playback.Seed()
+		// main made sure the level is there
+		g.levelIndex, _ = levelByName(g.options.Levels, g.playback.Level())
+		g.upgrades = g.playback.Upgrades()
 	}
-
-	g.enemies = enemies
-
-	if g.audioContext == nil {
-		g.audioContext = audio.NewContext(48000)
+	if g.seed == 0 {
+		g.seed = time.Now().UnixNano()
 	}
+	g.runScore = world.Score{}
+	g.coins = 0
+	g.highScoreRank = -1
+	g.startLevel()
+}
 
-	jumpD, err := vorbis.Decode(g.audioContext, bytes.NewReader(raudio.Jump_ogg))
-	if err != nil {
-		log.Fatal(err)
-	}
-	g.jumpPlayer, err = audio.NewPlayer(g.audioContext, jumpD)
-	if err != nil {
-		log.Fatal(err)
-	}
+func (g *Game) level() *level.Level {
+	return g.options.Levels[g.levelIndex]
+}
 
-	jabD, err := wav.Decode(g.audioContext, bytes.NewReader(raudio.Jab_wav))
-	if err != nil {
-		log.Fatal(err)
-	}
-	g.hitPlayer, err = audio.NewPlayer(g.audioContext, jabD)
-	if err != nil {
-		log.Fatal(err)
+// levelByName returns the index of the named level in levels.
+func levelByName(levels []*level.Level, name string) (int, bool) {
+	for i, l := range levels {
+		if l.Name == name {
+			return i, true
+		}
 	}
+	return 0, false
 }
 
-func (g *Game) isKeyJustPressed() bool {
-	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-		return true
+func (g *Game) startLevel() {
+	g.world = world.New(g.level(), g.seed, g.upgrades)
+	if g.options.RecordPath != "" {
+		g.recording = replay.New(g.seed, g.level().Name, g.upgrades)
 	}
-	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
-		return true
+	if g.playback != nil {
+		g.playback.Rewind()
 	}
-	return false
 }
 
-func (g *Game) handleMovement() {
-	isLeftPressed := g.isKeyPressed([]ebiten.Key{ebiten.KeyA}) || g.isKeyPressed([]ebiten.Key{ebiten.KeyArrowLeft})
-	isRightPressed := g.isKeyPressed([]ebiten.Key{ebiten.KeyD}) || g.isKeyPressed([]ebiten.Key{ebiten.KeyArrowRight})
-	areBothPressed := g.isKeyPressed([]ebiten.Key{ebiten.KeyA, ebiten.KeyD}) || g.isKeyPressed([]ebiten.Key{ebiten.KeyArrowLeft, ebiten.KeyArrowRight})
+// completeLevel banks the level's score and coins and shows the level
+// complete screen.
+func (g *Game) completeLevel() {
+	g.mode = ModeLevelComplete
+	g.runScore = g.runScore.Add(g.world.Score)
+	g.coins += g.world.Coins
+	g.saveRecording()
+}
 
-	g.movingLeft = !areBothPressed && isLeftPressed
+// nextLevel continues after the level complete screen, stopping at the
+// inn before the next level.
+func (g *Game) nextLevel() {
+	switch {
+	case g.playback != nil:
+		// A replay covers a single level.
+		g.init()
+		g.mode = ModeTitle
+	case g.levelIndex+1 >= len(g.options.Levels):
+		g.endRun(ModeEnding)
+	default:
+		g.enterInn()
+	}
+}
 
-	isHit, collidableDirection := g.hit()
+// isContinueJustPressed reports whether the player wants to move on from a
+// screen shown between levels and runs: the jump action, a tap or any
+// gamepad button.
+func (g *Game) isContinueJustPressed() bool {
+	_, _, tapped := justTapped()
+	return g.controls.JustPressed(input.Jump) || tapped || g.pads.AnyButtonJustPressed()
+}
 
-	if g.isKeyJustPressed() {
-		// not more than 2 jumps
-		// allow jump from collision/platforms
-		if g.jumpCount < 2 {
-			g.vy16 = -jumpVelocity * 2
-			g.jumpCount++
-		} else if isHit || g.groundTouch() || g.hitPlatformTop() {
-			g.jumpCount = 0
-		}
-		g.jumpPlayer.Rewind()
-		g.jumpPlayer.Play()
+// readInput turns the actions held into the input the world consumes for
+// one tick.
+func (g *Game) readInput() world.Input {
+	return world.Input{
+		Left:  g.controls.Pressed(input.MoveLeft),
+		Right: g.controls.Pressed(input.MoveRight),
+		Jump:  g.controls.JustPressed(input.Jump),
+		Fire:  g.controls.JustPressed(input.Fire),
 	}
+}
 
-	if areBothPressed {
-		g.vx16 = 0
-	} else if isLeftPressed && collidableDirection != "right" {
-		g.vx16 -= moveAcceleration
-		if g.vx16 < -maxMoveVelocity {
-			g.vx16 = -maxMoveVelocity
-		}
-		g.cameraX += g.vx16
-	} else if isRightPressed && collidableDirection != "left" {
-		g.vx16 += moveAcceleration
-		if g.vx16 > maxMoveVelocity {
-			g.vx16 = maxMoveVelocity
-		}
-		g.cameraX += g.vx16
-	} else {
-		g.vx16 = 0
+// nextInput returns the input for the coming tick, taken from the playback
+// when there is one. It reports false when the playback has run out.
+func (g *Game) nextInput() (world.Input, bool) {
+	if g.playback != nil {
+		return g.playback.Next()
 	}
+	return g.readInput(), true
+}
 
-	g.x16 += g.vx16
-	g.y16 += g.vy16
-
-	// Gravity
-	g.vy16 += gravityAcceleration
-	if g.vy16 > maxGravityVelocity {
-		g.vy16 = maxGravityVelocity
-	}
+// gameOver ends the current run and writes the level's recording, if any.
+func (g *Game) gameOver() {
+	g.saveRecording()
+	g.endRun(ModeGameOver)
 }
 
-func (g *Game) isKeyPressed(keys []ebiten.Key) bool {
-	keyMap := make(map[ebiten.Key]int)
-	for _, key := range keys {
-		keyMap[key] = -1
-	}
-	for _, v := range inpututil.PressedKeys() {
-		if keyMap[v] == -1 {
-			keyMap[v] = 1
-		}
+func (g *Game) saveRecording() {
+	if g.recording == nil || len(g.recording.Inputs) == 0 {
+		return
 	}
-	for _, v := range keyMap {
-		if v == -1 {
-			return false
-		}
+	if err := g.recording.Save(g.options.RecordPath); err != nil {
+		log.Printf("saving replay: %v", err)
 	}
-	return true
+	g.recording = nil
 }
 
 func (g *Game) isRestartJustPressed() bool {
-	return g.isKeyPressed([]ebiten.Key{ebiten.KeyControlLeft, ebiten.KeyR})
+	return g.controls.JustPressed(input.Restart)
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
@@ -358,103 +334,152 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
 }
 
 func (g *Game) Update() error {
+	g.sound.Update()
+	if g.assetWatcher != nil {
+		g.assetWatcher.update()
+	}
+	g.updateGamepads()
+	g.controls.Update(g.keyboardActions() | g.pads.Actions() | g.updateTouches())
+
 	switch g.mode {
 	case ModeTitle:
-		if g.isKeyJustPressed() {
-			g.mode = ModeGame
-		}
+		g.updateTitle()
 	case ModeGame:
 		if g.isRestartJustPressed() {
-			g.mode = ModeGameOver
+			g.gameOver()
+			break
 		}
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyF) {
-
-			g.projectiles = append(g.projectiles, Projectile{baseCollider: BaseCollider{x: g.x16, y: screenHeight - 60 - (384 - g.y16)}, lifespan: 200, isMovingLeft: g.movingLeft})
+		if g.controls.JustPressed(input.Pause) || !ebiten.IsFocused() {
+			g.pause()
+			break
 		}
 
-		g.handleMovement()
-		g.moveEnemies()
-
-		if g.hitKillbox() {
-			g.mode = ModeGameOver
+		in, ok := g.nextInput()
+		if !ok {
+			g.gameOver()
+			break
 		}
-
-		if g.hitPlatformTop() {
-			g.vy16 = 0
+		if g.recording != nil {
+			g.recording.Record(in)
 		}
 
-		// if g.hit() {
-		// 	// fmt.Printf("it is hit")
-		// 	// g.hitPlayer.Rewind()
-		// 	// g.hitPlayer.Play()
-		// 	//g.mode = ModeGameOver
-		// 	//g.gameoverCount = 30
-		// 	//g.vy16 = 0
-		// 	// g.vx16 = 0
-		// 	// fmt.Print("-----COLISSION-----")
-		// }
-
-		if g.groundTouch() {
-			g.vy16 = 0
+		events := g.world.Step(in)
+	handle:
+		for _, e := range events {
+			if name, ok := eventSounds[e]; ok {
+				g.sound.Play(name)
+			}
+			switch e {
+			case world.EventDeath:
+				// nothing after a death counts
+				g.gameOver()
+				break handle
+			case world.EventGoalReached:
+				g.completeLevel()
+			}
 		}
+		g.updateAnimations(events)
 	case ModeGameOver:
-		g.hitPlayer.Play()
+		g.updateAnimations(nil)
 		if g.gameoverCount > 0 {
 			g.gameoverCount--
 		}
-		if g.gameoverCount == 0 && g.isKeyJustPressed() {
+		if g.gameoverCount == 0 && g.isContinueJustPressed() {
+			g.init()
+			g.mode = ModeTitle
+		}
+	case ModeLevelComplete:
+		if g.isContinueJustPressed() || g.playback != nil {
+			g.nextLevel()
+		}
+	case ModeInn:
+		g.updateInn()
+	case ModeNameEntry:
+		g.updateAnimations(nil)
+		g.updateNameEntry()
+	case ModeControls:
+		g.updateControls()
+	case ModePause:
+		g.updatePause()
+	case ModeOptions:
+		g.updateOptions()
+	case ModeEnding:
+		if g.isContinueJustPressed() {
 			g.init()
 			g.mode = ModeTitle
 		}
 	}
+
+	g.updateMusic()
 	return nil
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
+	switch g.mode {
+	case ModeInn:
+		g.drawInn(screen)
+	case ModeControls:
+		g.drawControls(screen)
+	case ModeOptions:
+		g.drawOptions(screen)
+	case ModePause:
+		g.drawOutdoors(screen)
+		g.drawPause(screen)
+	default:
+		g.drawOutdoors(screen)
+	}
+	if g.gamepadMessageTicks > 0 {
+		text.Draw(screen, g.gamepadMessage, smallArcadeFont, (screenWidth-len(g.gamepadMessage)*smallFontSize)/2, screenHeight-3*smallFontSize, color.White)
+	}
+}
+
+// drawOutdoors draws the level and the screens shown over it.
+func (g *Game) drawOutdoors(screen *ebiten.Image) {
 	screen.Fill(color.RGBA{0x80, 0xa0, 0xc0, 0xff}) //background color
 
 	// render inn
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Reset()
-	op.GeoM.Translate(float64(600-g.cameraX), 190) // why 190? idk
-	screen.DrawImage(innImage, op)
+	op.GeoM.Translate(float64(g.world.Goal.X-g.world.CameraX), float64(g.world.Goal.Y))
+	screen.DrawImage(assetImage(imageInn), op)
 
 	g.drawTiles(screen)
-	for i := len(g.projectiles) - 1; i >= 0; i-- {
-		g.drawProjectile(screen, g.projectiles[i])
-		if g.projectiles[i].isMovingLeft {
-			g.projectiles[i].baseCollider.x -= projectileSpeed
-		} else {
-			g.projectiles[i].baseCollider.x += projectileSpeed
-		}
-		g.projectiles[i].lifespan -= 1
-		if g.projectiles[i].lifespan < 1 {
-			g.projectiles = append(g.projectiles[:i], g.projectiles[i+1:]...)
-		}
-	}
-
-	platformA := Platform{baseCollider: BaseCollider{x: 320, y: 400}, tileCount: 4}
-	platformB := Platform{baseCollider: BaseCollider{x: 480, y: 320}, tileCount: 6}
-	g.platforms = []Platform{platformA, platformB}
-	g.drawPlatforms(screen, g.platforms, 0, 290)
-
-	killBoxA := Platform{baseCollider: BaseCollider{x: 440, y: 360}, tileCount: 1}
-	g.killBoxes = []Platform{killBoxA}
-	g.drawPlatforms(screen, g.killBoxes, 96, 290)
+	g.drawDecorations(screen)
+	g.drawEntities(screen)
 
 	if g.mode != ModeTitle {
 		g.drawGopher(screen)
-		g.drawEnemies(screen, g.enemies)
 	}
 	var titleTexts []string
 	var texts []string
 	switch g.mode {
 	case ModeTitle:
 		titleTexts = []string{"GO INN"}
-		texts = []string{"", "", "", "", "", "", "", "PRESS SPACE KEY"}
+		g.titleMenu.draw(screen, (screenWidth-10*smallFontSize)/2, 168)
 	case ModeGameOver:
 		texts = []string{"", "GAME OVER!"}
+	case ModeNameEntry:
+		g.drawNameEntry(screen)
+	case ModeLevelComplete:
+		s := g.world.Score
+		texts = []string{
+			"", "LEVEL COMPLETE!", "",
+			fmt.Sprintf("TIME  %s", formatTicks(g.world.Ticks)),
+			fmt.Sprintf("COINS %5d", s.Coins),
+			fmt.Sprintf("KILLS %5d", s.Kills),
+			fmt.Sprintf("BONUS %5d", s.Time),
+			fmt.Sprintf("TOTAL %5d", s.Total()),
+		}
+	case ModeEnding:
+		s := g.runScore
+		titleTexts = []string{"YOU MADE IT"}
+		texts = []string{
+			"", "", "", "",
+			fmt.Sprintf("COINS %5d", s.Coins),
+			fmt.Sprintf("KILLS %5d", s.Kills),
+			fmt.Sprintf("BONUS %5d", s.Time),
+			fmt.Sprintf("TOTAL %5d", s.Total()),
+		}
 	}
 	for i, l := range titleTexts {
 		x := (screenWidth - len(l)*titleFontSize) / 2
@@ -465,6 +490,10 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		text.Draw(screen, l, arcadeFont, x, (i+4)*fontSize, color.White)
 	}
 
+	if g.mode == ModeTitle || g.mode == ModeGameOver {
+		g.drawHighScores(screen, 230)
+	}
+
 	if g.mode == ModeTitle {
 		msg := []string{
 			"Go INN",
@@ -475,140 +504,136 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		}
 	}
 
-	scoreStr := fmt.Sprintf("%04d", g.score())
+	p := g.world.Player
+	score := g.score()
+	scoreStr := fmt.Sprintf("%04d", score.Total())
 	text.Draw(screen, scoreStr, arcadeFont, screenWidth-len(scoreStr)*fontSize, fontSize, color.White)
-	ebitenutil.DebugPrint(screen, fmt.Sprintf("TPS: %0.2f X: %1d Y: %2d VX: %1d VY: %2d", ebiten.CurrentTPS(), g.x16, g.y16, g.vx16, g.vy16))
-}
-
-func (g *Game) score() int {
-	x := g.x16 / tileSize
-	if (x - pipeStartOffsetX) <= 0 {
-		return 0
-	}
-	return floorDiv(x-pipeStartOffsetX, pipeIntervalX)
-}
-
-func (g *Game) hit() (bool, string) {
-
-	const (
-		gopherWidth  = 30
-		gopherHeight = 60
-	)
-
-	for i := 0; i < len(g.platforms); i++ {
-		p := g.platforms[i]
-		player := Collidable{baseCollider: BaseCollider{x: g.x16, y: g.y16}, width: gopherWidth, height: gopherHeight}
-		platform := Collidable{baseCollider: BaseCollider{x: p.baseCollider.x, y: p.baseCollider.y}, width: p.tileCount * tileSize, height: tileSize}
-
-		// fmt.Printf("+++++ player x: %d, y: %d, width: %d, height: %d", player.baseCollider.x, player.baseCollider.y, player.width, player.height)
-		// fmt.Printf("----- platform x: %d, y: %d, width: %d, height: %d", platform.baseCollider.x, platform.baseCollider.y, platform.width, platform.height)
-
-		verticalOverlap := (math.Abs(float64(player.baseCollider.y)-float64(platform.baseCollider.y)) < float64(player.height))
-
-		collidableLeft := verticalOverlap && math.Abs(float64(player.baseCollider.x)-float64(platform.baseCollider.x)) < float64(player.width)
-		collidableRight := verticalOverlap && math.Abs(float64(player.baseCollider.x)-float64(platform.baseCollider.x+platform.width)) < float64(player.width)
-
-		if collidableRight {
-			// fmt.Printf("---IT COLLIDES RIGHT")
-			return true, "right"
+	if g.mode != ModeTitle {
+		lines := []string{
+			fmt.Sprintf("COINS %4d", score.Coins),
+			fmt.Sprintf("KILLS %4d", score.Kills),
+			fmt.Sprintf("BONUS %4d", score.Time),
+			fmt.Sprintf("PURSE %4d", g.purse()),
 		}
-
-		if collidableLeft {
-			// fmt.Printf("---IT COLLIDES left")
-			return true, "left"
+		for i, l := range lines {
+			text.Draw(screen, l, smallArcadeFont, screenWidth-len(l)*smallFontSize, fontSize+(i+1)*(smallFontSize+4), color.White)
 		}
 	}
-
-	return false, ""
+	if g.settings.Debug {
+		ebitenutil.DebugPrint(screen, fmt.Sprintf("TPS: %0.2f X: %1d Y: %2d VX: %1d VY: %2d", ebiten.CurrentTPS(), p.X16, p.Y16, p.VX16, p.VY16))
+	}
+	text.Draw(screen, fmt.Sprintf("SEED %d", g.world.Seed), smallArcadeFont, 4, 32, color.White)
+	if g.mode != ModeTitle {
+		g.drawHealth(screen)
+	}
+	if g.mode == ModeGame {
+		g.drawTouchControls(screen)
+	}
 }
 
-func (g *Game) hitKillbox() bool {
-	const (
-		gopherWidth  = 60
-		gopherHeight = 75
-	)
-	for _, killbox := range g.killBoxes {
-		if g.x16+gopherWidth > killbox.baseCollider.x && g.x16 < killbox.baseCollider.x+tileSize*killbox.tileCount {
-			if g.y16 < killbox.baseCollider.y+tileSize && g.y16+gopherHeight > killbox.baseCollider.y {
-				return true
-			}
+func (g *Game) drawHealth(screen *ebiten.Image) {
+	const size = 12
+	p := g.world.Player
+	text.Draw(screen, "HP", smallArcadeFont, 4, 52, color.White)
+	for i := 0; i < p.MaxHealth; i++ {
+		c := color.RGBA{0x40, 0x40, 0x40, 0xff}
+		if i < p.Health {
+			c = color.RGBA{0xe0, 0x30, 0x30, 0xff}
 		}
+		ebitenutil.DrawRect(screen, float64(32+i*(size+4)), 40, size, size, c)
 	}
-	return false
 }
 
-func (g *Game) hitPlatformTop() bool {
-	const (
-		gopherWidth  = 60
-		gopherHeight = 75
-	)
-	for _, killbox := range g.platforms {
-		if g.x16+gopherWidth > killbox.baseCollider.x && g.x16 < killbox.baseCollider.x+tileSize*killbox.tileCount {
-			if g.y16+gopherHeight < killbox.baseCollider.y+tileSize && g.y16+gopherHeight > killbox.baseCollider.y {
-				return true
-			}
-		}
+// levelBanked reports whether the current level's score and coins have
+// already been added to the run's.
+func (g *Game) levelBanked() bool {
+	mode := g.mode
+	if mode == ModeNameEntry {
+		mode = g.afterNameEntry
 	}
-	return false
+	return mode == ModeLevelComplete || mode == ModeEnding
 }
 
-func (g *Game) drawPlatforms(screen *ebiten.Image, platforms []Platform, offsetX int, offsetY int) {
-	op := &ebiten.DrawImageOptions{}
+// score is the run's score so far, including the current level.
+func (g *Game) score() world.Score {
+	if g.levelBanked() {
+		return g.runScore
+	}
+	return g.runScore.Add(g.world.Score)
+}
 
-	for _, platform := range platforms {
-		for i := 0; i < platform.tileCount; i++ {
-			op.GeoM.Reset()
-			op.GeoM.Translate(float64(platform.baseCollider.x+tileSize*i-g.cameraX), float64(platform.baseCollider.y))
-			screen.DrawImage(tilesImage.SubImage(image.Rect(offsetX, offsetY, offsetX+tileSize, offsetY+tileSize)).(*ebiten.Image), op)
-		}
+// purse is the run's coins so far, including the current level.
+func (g *Game) purse() int {
+	if g.levelBanked() {
+		return g.coins
 	}
+	return g.coins + g.world.Coins
 }
 
-func (g *Game) drawProjectile(screen *ebiten.Image, projectile Projectile) {
-	op := &ebiten.DrawImageOptions{}
+// formatTicks formats a number of ticks as seconds with one decimal.
+func formatTicks(ticks int) string {
+	return fmt.Sprintf("%d.%d", ticks/world.TicksPerSecond, ticks%world.TicksPerSecond*10/world.TicksPerSecond)
+}
 
-	op.GeoM.Reset()
-	op.GeoM.Translate(float64(projectile.baseCollider.x-g.cameraX), float64(projectile.baseCollider.y))
-	screen.DrawImage(bulletImage, op)
+// defaultTiles are the tiles drawn from the tiles image for tile sprites when
+// the level has no tileset of its own.
+var defaultTiles = map[string]image.Point{
+	"platform": {0, 290},
+	"killbox":  {96, 290},
 }
 
-func (g *Game) groundTouch() bool {
-	const gopherHeight = 60
-	_, h := gopherImage.Size()
+func (g *Game) drawEntities(screen *ebiten.Image) {
+	op := &ebiten.DrawImageOptions{}
 
-	y0 := g.y16 + (h-gopherHeight)/2
-	y1 := y0 + gopherHeight
-	if y1 >= screenHeight-tileSize {
-		// fmt.Printf("---ground---")
-		return true
-	}
-	return false
-}
+	for _, e := range g.world.Entities {
+		if e.Sprite == nil || e.Position == nil {
+			continue
+		}
+		if e.Sprite.Tiles > 0 {
+			g.drawTileRow(screen, e)
+			continue
+		}
+		// Actors only appear once the game has started.
+		if g.mode == ModeTitle {
+			continue
+		}
 
-func (g *Game) moveEnemies() {
-	// enemy.vx -= moveAcceleration
-	// if enemy.vx < -2 {
-	// 	enemy.vx = -2
-	// }
-	for index := range g.enemies {
-		if g.enemies[index].baseCollider.x > g.x16 {
-			g.enemies[index].baseCollider.x -= 1
-			g.enemies[index].isMovingLeft = true
-		} else {
-			g.enemies[index].isMovingLeft = false
-			g.enemies[index].baseCollider.x += 1
+		op.GeoM.Reset()
+		if a := g.enemyAnims[e.ID]; a != nil && g.animWorld == g.world {
+			drawFrame(screen, assetImage(imageEnemySheet), &a.Player, e.Position.X-g.world.CameraX, e.Position.Y-g.world.CameraY,
+				e.Collider.Width, e.Collider.Height, e.Sprite.FlipX, op)
+			continue
 		}
+		img := assetImage(e.Sprite.Image)
+		if e.Sprite.FlipX {
+			flipAsset(img, op)
+		}
+		op.GeoM.Translate(float64(e.Position.X-g.world.CameraX), float64(e.Position.Y-g.world.CameraY))
+		op.Filter = ebiten.FilterLinear
+		screen.DrawImage(img, op)
+	}
+	if g.mode != ModeTitle {
+		g.drawCorpses(screen)
 	}
+}
 
-	// g.cameraX += g.vx16
+func (g *Game) drawTileRow(screen *ebiten.Image, e *world.Entity) {
+	op := &ebiten.DrawImageOptions{}
 
-	// call on update
-	// reduce value of enemy's x position by 1
-	//will it repaint enemy on gopherDraw?
+	offset := defaultTiles[e.Sprite.Image]
+	tile := assetImage(imageTiles).SubImage(image.Rect(offset.X, offset.Y, offset.X+tileSize, offset.Y+tileSize)).(*ebiten.Image)
+	if ts := g.level().Tileset; ts != nil {
+		tile = tilesetImages[ts.Image].SubImage(image.Rect(ts.TileRect(e.Sprite.Tile))).(*ebiten.Image)
+	}
+	for i := 0; i < e.Sprite.Tiles; i++ {
+		op.GeoM.Reset()
+		op.GeoM.Translate(float64(e.Position.X+tileSize*i-g.world.CameraX), float64(e.Position.Y-g.world.CameraY))
+		screen.DrawImage(tile, op)
+	}
 }
 
 func flipAsset(image *ebiten.Image, op *ebiten.DrawImageOptions) {
-	w, _ := enemyImage.Size()
+	w, _ := image.Size()
 
 	op.GeoM.Scale(-1, 1)
 	op.GeoM.Translate(float64(w), 0)
@@ -616,10 +641,8 @@ func flipAsset(image *ebiten.Image, op *ebiten.DrawImageOptions) {
 
 func (g *Game) drawTiles(screen *ebiten.Image) {
 	const (
-		nx           = screenWidth / tileSize
-		ny           = screenHeight / tileSize
-		pipeTileSrcX = 128
-		pipeTileSrcY = 192
+		nx = screenWidth / tileSize
+		ny = screenHeight / tileSize
 	)
 
 	op := &ebiten.DrawImageOptions{}
@@ -627,56 +650,107 @@ func (g *Game) drawTiles(screen *ebiten.Image) {
 	for i := -2; i < nx+1; i++ {
 		// ground
 		op.GeoM.Reset()
-		op.GeoM.Translate(float64(i*tileSize-floorMod(g.cameraX, tileSize)),
-			float64((ny-1)*tileSize-floorMod(g.cameraY, tileSize)))
-		screen.DrawImage(tilesImage.SubImage(image.Rect(0, 0, tileSize, tileSize)).(*ebiten.Image), op)
-		// pipe
-		treeHeight := 128
-		approxGroundCoord := 182
-		spacing := 8 * tileSize
-		op.GeoM.Reset()
-		op.GeoM.Translate(float64(spacing*i-floorMod(g.cameraX, spacing)), float64(approxGroundCoord+treeHeight))
-		screen.DrawImage(treeImage, op)
+		op.GeoM.Translate(float64(i*tileSize-floorMod(g.world.CameraX, tileSize)),
+			float64((ny-1)*tileSize-floorMod(g.world.CameraY, tileSize)))
+		screen.DrawImage(assetImage(imageTiles).SubImage(image.Rect(0, 0, tileSize, tileSize)).(*ebiten.Image), op)
 	}
 }
 
-func (g *Game) drawGopher(screen *ebiten.Image) {
+func (g *Game) drawDecorations(screen *ebiten.Image) {
 	op := &ebiten.DrawImageOptions{}
-	w, h := gopherImage.Size()
-	if g.movingLeft {
-		flipAsset(gopherImage, op)
+
+	for _, d := range g.level().Decorations {
+		op.GeoM.Reset()
+		op.GeoM.Translate(float64(d.X-g.world.CameraX), float64(d.Y-g.world.CameraY))
+		screen.DrawImage(assetImage(d.Image), op)
 	}
-	op.GeoM.Translate(-float64(w)/2.0, -float64(h)/2.0)
-	op.GeoM.Rotate(float64(g.vy16) / 96.0 * math.Pi / 6)
-	op.GeoM.Translate(float64(w)/2.0, float64(h)/2.0)
-	op.GeoM.Translate(float64(g.x16)-float64(g.cameraX), float64(g.y16)-float64(g.cameraY))
-	//op.Filter = ebiten.FilterLinear
-	screen.DrawImage(gopherImage, op)
 }
 
-func (g *Game) drawEnemies(screen *ebiten.Image, enemies []Enemy) {
+func (g *Game) drawGopher(screen *ebiten.Image) {
+	p := g.world.Player
+	// blink while invincible
+	if p.Invincible > 0 && p.Invincible/4%2 == 0 {
+		return
+	}
 	op := &ebiten.DrawImageOptions{}
-	// w, h := enemyImage.Size()
-
-	for _, enemy := range enemies {
-		op.GeoM.Reset()
-
-		if enemy.isMovingLeft {
-			flipAsset(enemyImage, op)
+	if g.playerAnim == nil || g.animWorld != g.world {
+		img := assetImage(imagePlayer)
+		if p.MovingLeft {
+			flipAsset(img, op)
 		}
-
-		op.GeoM.Translate(float64(enemy.baseCollider.x-g.cameraX), float64(enemy.baseCollider.y))
-		op.Filter = ebiten.FilterLinear
-		screen.DrawImage(enemyImage, op)
+		op.GeoM.Translate(float64(p.X16-g.world.CameraX), float64(p.Y16-g.world.CameraY))
+		screen.DrawImage(img, op)
+		return
 	}
-
-	//make check for position to approach each other
+	drawFrame(screen, assetImage(imagePlayerSheet), &g.playerAnim.Player, p.X16-g.world.CameraX, p.Y16-g.world.CameraY,
+		world.PlayerWidth, world.PlayerHeight, p.MovingLeft, op)
 }
 
 func main() {
-	ebiten.SetWindowSize(screenWidth, screenHeight)
+	seed := flag.Int64("seed", 0, "world seed; 0 picks a new seed for every run")
+	record := flag.String("record", "", "write the inputs of each finished level to this replay file")
+	playback := flag.String("replay", "", "play back a replay file instead of reading the keyboard")
+	levelPaths := flag.String("level", "", "comma separated JSON or Tiled TMX level files to play instead of the built-in levels")
+	dev := flag.Bool("dev", false, "load the assets from the images directory and reload images when they change")
+	flag.Parse()
+
+	options := Options{Seed: *seed, RecordPath: *record}
+	if path, err := persist.Path(highscore.FileName); err != nil {
+		log.Printf("high scores will not be kept: %v", err)
+	} else {
+		options.HighScorePath = path
+	}
+	if path, err := persist.Path(save.FileName); err != nil {
+		log.Printf("games will not be saved: %v", err)
+	} else {
+		options.SavePath = path
+	}
+	if path, err := persist.Path(input.FileName); err != nil {
+		log.Printf("controls will not be saved: %v", err)
+	} else {
+		options.ControlsPath = path
+	}
+	if path, err := persist.Path(settings.FileName); err != nil {
+		log.Printf("settings will not be saved: %v", err)
+	} else {
+		options.SettingsPath = path
+	}
+	options.Settings = loadSettings(options.SettingsPath)
+
+	loader := &assets.Loader{FS: images.FS, Files: builtinAssets}
+	if *dev {
+		if fi, err := os.Stat(devAssetDir); err != nil || !fi.IsDir() {
+			log.Printf("no %s directory, using the built-in assets", devAssetDir)
+		} else {
+			loader.FS = os.DirFS(devAssetDir)
+			options.AssetDir = devAssetDir
+		}
+	}
+	if err := loadAssets(loader); err != nil {
+		log.Fatal(err)
+	}
+	var err error
+	if options.Levels, err = loadLevels(*levelPaths); err != nil {
+		log.Fatal(err)
+	}
+	if *playback != "" {
+		r, err := replay.Load(*playback)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, ok := levelByName(options.Levels, r.Level); !ok {
+			log.Fatalf("replay %s: level %q is not one of the levels played, pass its file with -level", *playback, r.Level)
+		}
+		options.Playback = r
+	}
+
+	applySettings(options.Settings)
 	ebiten.SetWindowTitle("Go Inn")
-	if err := ebiten.RunGame(NewGame()); err != nil {
+	g := NewGame(options)
+	if err := ebiten.RunGame(g); err != nil {
 		panic(err)
 	}
+	// The window was closed mid-run.
+	g.saveRecording()
+	g.saveRun()
 }
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+
+	"github.com/mariuseis/go-inn/input"
+)
+
+// nav is the input that moves through menus in one tick.
+type nav struct {
+	up, down, left, right bool
+	confirm, back         bool
+	// tapped is set when the screen was touched at tapX, tapY.
+	tapped     bool
+	tapX, tapY int
+}
+
+// menuNav reads the arrow keys or WASD, Enter or the jump action to
+// confirm and Escape to go back, and the same from the d-pad and the A,
+// Start and B buttons of a gamepad.
+func (g *Game) menuNav() nav {
+	pressed := g.pads.JustPressed()
+	tapX, tapY, tapped := justTapped()
+	return nav{
+		up:      inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) || inpututil.IsKeyJustPressed(ebiten.KeyW) || pressed&input.PadUp != 0,
+		down:    inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) || inpututil.IsKeyJustPressed(ebiten.KeyS) || pressed&input.PadDown != 0,
+		left:    inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) || inpututil.IsKeyJustPressed(ebiten.KeyA) || pressed&input.PadLeft != 0,
+		right:   inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) || inpututil.IsKeyJustPressed(ebiten.KeyD) || pressed&input.PadRight != 0,
+		confirm: inpututil.IsKeyJustPressed(ebiten.KeyEnter) || g.controls.JustPressed(input.Jump) || pressed&(input.PadA|input.PadStart) != 0,
+		back:    inpututil.IsKeyJustPressed(ebiten.KeyEscape) || pressed&input.PadB != 0,
+		tapped:  tapped,
+		tapX:    tapX,
+		tapY:    tapY,
+	}
+}
+
+// menu is a vertical list of choices, moved through with up and down and
+// picked with confirm, or picked by tapping it.
+type menu struct {
+	items  []string
+	cursor int
+	// x and y are where the menu was last drawn.
+	x, y int
+}
+
+const menuLineHeight = smallFontSize + 8
+
+// update moves the cursor and returns the index of the item picked this
+// tick, or -1.
+func (m *menu) update(n nav) int {
+	if len(m.items) == 0 {
+		return -1
+	}
+	if n.up {
+		m.cursor = (m.cursor + len(m.items) - 1) % len(m.items)
+	}
+	if n.down {
+		m.cursor = (m.cursor + 1) % len(m.items)
+	}
+	if n.confirm {
+		return m.cursor
+	}
+	if n.tapped && n.tapX >= m.x {
+		// text is drawn above its baseline
+		i := floorDiv(n.tapY-m.y+menuLineHeight, menuLineHeight)
+		if i >= 0 && i < len(m.items) {
+			m.cursor = i
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *menu) draw(screen *ebiten.Image, x, y int) {
+	const lineHeight = menuLineHeight
+	m.x, m.y = x, y
+	for i, item := range m.items {
+		prefix := "  "
+		if i == m.cursor {
+			prefix = "> "
+		}
+		text.Draw(screen, prefix+item, smallArcadeFont, x, y+i*lineHeight, color.White)
+	}
+}
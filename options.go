@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+
+	"github.com/mariuseis/go-inn/settings"
+)
+
+// The rows of the options screen.
+const (
+	optionMasterVolume = iota
+	optionMusicVolume
+	optionSFXVolume
+	optionMute
+	optionFullscreen
+	optionWindowScale
+	optionVsync
+	optionDebug
+	optionControls
+	optionBack
+)
+
+// loadSettings reads the settings, using the defaults when there are none
+// or they can't be used.
+func loadSettings(path string) settings.Settings {
+	if path == "" {
+		return settings.Default()
+	}
+	s, err := settings.Load(path)
+	if err != nil {
+		log.Printf("loading settings: %v", err)
+	}
+	return s
+}
+
+// applySettings sets up the window as the settings say. It is called at
+// startup, before the game runs.
+func applySettings(s settings.Settings) {
+	ebiten.SetFullscreen(s.Fullscreen)
+	ebiten.SetVsyncEnabled(s.Vsync)
+	ebiten.SetWindowSize(screenWidth*s.WindowScale, screenHeight*s.WindowScale)
+}
+
+// openOptions shows the options screen, returning to the current mode when
+// it is left.
+func (g *Game) openOptions() {
+	g.optionsBack = g.mode
+	g.optionsMenu = &menu{}
+	g.mode = ModeOptions
+}
+
+func onOff(b bool) string {
+	if b {
+		return "ON"
+	}
+	return "OFF"
+}
+
+func (g *Game) optionItems() []string {
+	s := g.settings
+	return []string{
+		optionMasterVolume: fmt.Sprintf("MASTER VOLUME  %3d", s.MasterVolume),
+		optionMusicVolume:  fmt.Sprintf("MUSIC VOLUME   %3d", s.MusicVolume),
+		optionSFXVolume:    fmt.Sprintf("SFX VOLUME     %3d", s.SFXVolume),
+		optionMute:         fmt.Sprintf("MUTE           %3s", onOff(s.Mute)),
+		optionFullscreen:   fmt.Sprintf("FULLSCREEN     %3s", onOff(s.Fullscreen)),
+		optionWindowScale:  fmt.Sprintf("WINDOW SCALE   %2dX", s.WindowScale),
+		optionVsync:        fmt.Sprintf("VSYNC          %3s", onOff(s.Vsync)),
+		optionDebug:        fmt.Sprintf("DEBUG INFO     %3s", onOff(s.Debug)),
+		optionControls:     "CONTROLS",
+		optionBack:         "BACK",
+	}
+}
+
+func (g *Game) updateOptions() {
+	n := g.menuNav()
+	if n.back {
+		g.mode = g.optionsBack
+		return
+	}
+	g.optionsMenu.items = g.optionItems()
+	switch {
+	case n.left:
+		g.changeOption(g.optionsMenu.cursor, -1)
+	case n.right:
+		g.changeOption(g.optionsMenu.cursor, 1)
+	}
+	switch i := g.optionsMenu.update(n); i {
+	case -1:
+	case optionControls:
+		g.openControls()
+	case optionBack:
+		g.mode = g.optionsBack
+	default:
+		g.changeOption(i, 1)
+	}
+}
+
+// changeOption steps the option in the given row up or down, wrapping
+// around at either end, applies it and saves the settings.
+func (g *Game) changeOption(row, step int) {
+	s := &g.settings
+	switch row {
+	case optionMasterVolume:
+		s.MasterVolume = wrap(s.MasterVolume+step*settings.VolumeStep, 0, settings.MaxVolume)
+		g.applyVolumes()
+	case optionMusicVolume:
+		s.MusicVolume = wrap(s.MusicVolume+step*settings.VolumeStep, 0, settings.MaxVolume)
+		g.applyVolumes()
+	case optionSFXVolume:
+		s.SFXVolume = wrap(s.SFXVolume+step*settings.VolumeStep, 0, settings.MaxVolume)
+		g.applyVolumes()
+	case optionMute:
+		s.Mute = !s.Mute
+		g.applyVolumes()
+	case optionFullscreen:
+		s.Fullscreen = !s.Fullscreen
+		ebiten.SetFullscreen(s.Fullscreen)
+	case optionWindowScale:
+		s.WindowScale = wrap(s.WindowScale+step, 1, settings.MaxWindowScale)
+		ebiten.SetWindowSize(screenWidth*s.WindowScale, screenHeight*s.WindowScale)
+	case optionVsync:
+		s.Vsync = !s.Vsync
+		ebiten.SetVsyncEnabled(s.Vsync)
+	case optionDebug:
+		s.Debug = !s.Debug
+	default:
+		return
+	}
+	if g.options.SettingsPath == "" {
+		return
+	}
+	if err := s.Save(g.options.SettingsPath); err != nil {
+		log.Printf("saving settings: %v", err)
+	}
+}
+
+// wrap brings v into [lo, hi], going over from one end to the other.
+func wrap(v, lo, hi int) int {
+	switch {
+	case v < lo:
+		return hi
+	case v > hi:
+		return lo
+	}
+	return v
+}
+
+func (g *Game) drawOptions(screen *ebiten.Image) {
+	screen.Fill(color.RGBA{0x20, 0x28, 0x38, 0xff})
+
+	const title = "OPTIONS"
+	text.Draw(screen, title, arcadeFont, (screenWidth-len(title)*fontSize)/2, 2*fontSize, color.White)
+	g.optionsMenu.items = g.optionItems()
+	g.optionsMenu.draw(screen, (screenWidth-20*smallFontSize)/2, 5*fontSize)
+
+	const help = "LEFT AND RIGHT TO CHANGE"
+	text.Draw(screen, help, smallArcadeFont, (screenWidth-len(help)*smallFontSize)/2, screenHeight-2*fontSize, color.White)
+}
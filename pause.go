@@ -0,0 +1,69 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+
+	"github.com/mariuseis/go-inn/input"
+)
+
+const (
+	pauseResume  = "RESUME"
+	pauseRestart = "RESTART"
+	pauseOptions = "OPTIONS"
+	pauseQuit    = "QUIT TO TITLE"
+)
+
+var pauseDimColor = color.RGBA{0, 0, 0, 0x99}
+
+// pause freezes the level and shows the pause menu.
+func (g *Game) pause() {
+	g.mode = ModePause
+	g.pauseMenu = &menu{items: []string{pauseResume, pauseRestart, pauseOptions, pauseQuit}}
+}
+
+func (g *Game) updatePause() {
+	n := g.menuNav()
+	if n.back || g.controls.JustPressed(input.Pause) {
+		g.mode = ModeGame
+		return
+	}
+	i := g.pauseMenu.update(n)
+	if i < 0 {
+		return
+	}
+	switch g.pauseMenu.items[i] {
+	case pauseResume:
+		g.mode = ModeGame
+	case pauseRestart:
+		// start the level over, keeping what earlier levels earned
+		g.startLevel()
+		g.mode = ModeGame
+	case pauseOptions:
+		g.openOptions()
+	case pauseQuit:
+		g.quitToTitle()
+	}
+}
+
+// quitToTitle leaves the run, keeping it to be continued from the title
+// screen.
+func (g *Game) quitToTitle() {
+	g.saveRecording()
+	s := g.saveRun()
+	g.init()
+	g.saved = s
+	g.titleMenu.cursor = 0
+	g.mode = ModeTitle
+}
+
+func (g *Game) drawPause(screen *ebiten.Image) {
+	ebitenutil.DrawRect(screen, 0, 0, screenWidth, screenHeight, pauseDimColor)
+
+	const title = "PAUSED"
+	text.Draw(screen, title, titleArcadeFont, (screenWidth-len(title)*titleFontSize)/2, 4*titleFontSize, color.White)
+	g.pauseMenu.draw(screen, (screenWidth-15*smallFontSize)/2, 6*titleFontSize)
+}
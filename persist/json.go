@@ -0,0 +1,59 @@
+package persist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ReadJSON decodes a versioned JSON file into v. The file's top level
+// "version" has to be between 1 and version; v is decoded into as is, so
+// fields missing from the file keep what v held.
+func ReadJSON(r io.Reader, version int, v interface{}) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var head struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+	if head.Version < 1 || head.Version > version {
+		return fmt.Errorf("unsupported version %d", head.Version)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// WriteJSON encodes v as indented JSON. v carries its own version field.
+func WriteJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// Load reads the file at path with read. A missing file is returned as the
+// error from os.ReadFile, so errors.Is(err, os.ErrNotExist) tells it from
+// a file that could not be used; the latter's errors name the path.
+func Load(path string, read func(io.Reader) error) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := read(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Save writes the file at path with write, atomically as WriteFile does.
+func Save(path string, write func(io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := write(&buf); err != nil {
+		return err
+	}
+	return WriteFile(path, buf.Bytes())
+}
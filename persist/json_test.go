@@ -0,0 +1,97 @@
+package persist
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testFile struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	Size    int    `json:"size"`
+}
+
+func TestReadJSON(t *testing.T) {
+	for _, c := range []struct {
+		name, data string
+		want       testFile
+		ok         bool
+	}{
+		{"current", `{"version": 2, "name": "a", "size": 3}`, testFile{2, "a", 3}, true},
+		{"older", `{"version": 1, "name": "a"}`, testFile{1, "a", 10}, true},
+		{"missing fields keep defaults", `{"version": 2}`, testFile{2, "default", 10}, true},
+		{"newer", `{"version": 3, "name": "a"}`, testFile{}, false},
+		{"no version", `{"name": "a"}`, testFile{}, false},
+		{"negative version", `{"version": -1}`, testFile{}, false},
+		{"not json", `version: 2`, testFile{}, false},
+		{"truncated", `{"version": 2, "na`, testFile{}, false},
+		{"wrong type", `{"version": 2, "size": "big"}`, testFile{}, false},
+	} {
+		v := testFile{Name: "default", Size: 10}
+		err := ReadJSON(strings.NewReader(c.data), 2, &v)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: error %v", c.name, err)
+			continue
+		}
+		if c.ok && v != c.want {
+			t.Errorf("%s: got %+v, want %+v", c.name, v, c.want)
+		}
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "file.json")
+	want := testFile{Version: 1, Name: "saved", Size: 4}
+	if err := Save(path, func(w io.Writer) error { return WriteJSON(w, want) }); err != nil {
+		t.Fatal(err)
+	}
+
+	var got testFile
+	if err := Load(path, func(r io.Reader) error { return ReadJSON(r, 1, &got) }); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	read := func(r io.Reader) error { return ReadJSON(r, 1, &testFile{}) }
+
+	if err := Load(filepath.Join(dir, "missing.json"), read); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing file: %v, want os.ErrNotExist", err)
+	}
+
+	path := filepath.Join(dir, "broken.json")
+	if err := os.WriteFile(path, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	err := Load(path, read)
+	if err == nil || errors.Is(err, os.ErrNotExist) || !strings.HasPrefix(err.Error(), path+": ") {
+		t.Errorf("broken file: %v, want an error naming the path", err)
+	}
+}
+
+func TestSaveError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.json")
+	if err := os.WriteFile(path, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	failed := errors.New("failed")
+	err := Save(path, func(w io.Writer) error {
+		io.WriteString(w, "half")
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Errorf("error %v, want %v", err, failed)
+	}
+	if got, _ := os.ReadFile(path); !bytes.Equal(got, []byte("old")) {
+		t.Errorf("file %q after a failed write, want it unchanged", got)
+	}
+}
@@ -0,0 +1,57 @@
+// Package persist is where the game keeps files between runs: a directory
+// under the user's config directory, written to atomically so that a crash
+// or a full disk never leaves a half written file behind.
+package persist
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// AppDir is the name of the game's directory inside the user config
+// directory.
+const AppDir = "go-inn"
+
+// Path returns the path of the named file in the game's config directory.
+// The directory is not created until something is written to it.
+func Path(name string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, AppDir, name), nil
+}
+
+// WriteFile writes data to a temporary file next to path and renames it
+// over path, so readers see either the old contents or the new ones.
+// Missing parent directories are created.
+func WriteFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
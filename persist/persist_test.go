@@ -0,0 +1,74 @@
+package persist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// files returns the names in dir.
+func files(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+func TestWriteFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "a", "b")
+	path := filepath.Join(dir, "data.json")
+
+	for _, data := range []string{"first", "second, longer", "3"} {
+		if err := WriteFile(path, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != data {
+			t.Errorf("read %q, want %q", got, data)
+		}
+	}
+	// no temporary files are left behind
+	if names := files(t, dir); len(names) != 1 {
+		t.Errorf("files %v, want only data.json", names)
+	}
+}
+
+func TestWriteFileFailure(t *testing.T) {
+	dir := t.TempDir()
+	// a directory can't be replaced by a file
+	path := filepath.Join(dir, "data.json")
+	if err := os.Mkdir(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "keep"), []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFile(path, []byte("new")); err == nil {
+		t.Fatal("no error writing over a directory")
+	}
+	if names := files(t, dir); len(names) != 1 {
+		t.Errorf("files %v, want the temporary file removed", names)
+	}
+	if got, err := os.ReadFile(filepath.Join(path, "keep")); err != nil || string(got) != "old" {
+		t.Errorf("old contents %q, %v", got, err)
+	}
+}
+
+func TestPath(t *testing.T) {
+	path, err := Path("x.json")
+	if err != nil {
+		t.Skip(err)
+	}
+	if filepath.Base(path) != "x.json" || filepath.Base(filepath.Dir(path)) != AppDir {
+		t.Errorf("path %q, want .../%s/x.json", path, AppDir)
+	}
+}
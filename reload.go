@@ -0,0 +1,107 @@
+package main
+
+import (
+	"image"
+	"image/draw"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/mariuseis/go-inn/assets"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// devAssetDir is where -dev loads the assets from, relative to the working
+// directory.
+const devAssetDir = "images"
+
+// reloadTicks is how often the image files are checked for changes.
+const reloadTicks = world.TicksPerSecond / 2
+
+// assetWatcher reloads the images whose files change on disk while the
+// game runs.
+type assetWatcher struct {
+	dir      string
+	loader   *assets.Loader
+	modTimes map[string]time.Time
+	ticks    int
+}
+
+// newAssetWatcher starts watching the manifest's images in dir. Images that
+// come with ebiten are not watched.
+func newAssetWatcher(dir string) *assetWatcher {
+	w := &assetWatcher{
+		dir:      dir,
+		loader:   &assets.Loader{FS: os.DirFS(dir), Files: builtinAssets},
+		modTimes: map[string]time.Time{},
+	}
+	for _, info := range catalog.Manifest.Images {
+		if t, ok := w.modTime(info); ok {
+			w.modTimes[info.ID] = t
+		}
+	}
+	return w
+}
+
+func (w *assetWatcher) modTime(info assets.Image) (time.Time, bool) {
+	if _, builtin := builtinAssets[info.File]; builtin {
+		return time.Time{}, false
+	}
+	fi, err := os.Stat(filepath.Join(w.dir, filepath.FromSlash(info.File)))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return fi.ModTime(), true
+}
+
+// update checks the files every reloadTicks and swaps in the images that
+// changed. An image that fails to load is logged and the old one kept,
+// it is tried again when its file changes the next time.
+func (w *assetWatcher) update() {
+	w.ticks++
+	if w.ticks < reloadTicks {
+		return
+	}
+	w.ticks = 0
+
+	for _, info := range catalog.Manifest.Images {
+		t, ok := w.modTime(info)
+		if !ok || t.Equal(w.modTimes[info.ID]) {
+			continue
+		}
+		w.modTimes[info.ID] = t
+		img, err := w.loader.LoadImage(info)
+		if err != nil {
+			log.Print(err)
+			continue
+		}
+		catalog.SetImage(info.ID, img)
+		replaceImage(info.ID, img)
+		log.Printf("reloaded image %q", info.ID)
+	}
+}
+
+// replaceImage swaps the pixels of an asset image. An image of the same
+// size is changed in place, one of another size is replaced. The actor
+// sheets are laid out again, in case their size changed.
+func replaceImage(id string, img image.Image) {
+	b := img.Bounds()
+	old := assetImages[id]
+	if old == nil {
+		assetImages[id] = ebiten.NewImageFromImage(img)
+	} else if w, h := old.Size(); w == b.Dx() && h == b.Dy() {
+		rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+		old.ReplacePixels(rgba.Pix)
+	} else {
+		assetImages[id] = ebiten.NewImageFromImage(img)
+		old.Dispose()
+	}
+
+	if id == imagePlayerSheet || id == imageEnemySheet {
+		loadSheets()
+	}
+}
@@ -0,0 +1,252 @@
+// Package replay records the per-tick input of a run together with its seed
+// and plays it back. Because the world is deterministic, feeding the recorded
+// inputs to a world created from the same seed reproduces the run exactly.
+package replay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mariuseis/go-inn/world"
+)
+
+// File layout, all integers little endian or uvarint:
+//
+//	magic    [8]byte "GOINNRPL"
+//	version  uint8
+//	seed     int64
+//	level    uvarint length followed by the level name
+//	upgrades uvarint extra jumps, projectile speed and extra health
+//	runs     uvarint, followed by that many (length uvarint, input byte) pairs
+//
+// Consecutive identical inputs are stored as a single run, which keeps
+// recordings of long runs small since held keys rarely change.
+const (
+	magic   = "GOINNRPL"
+	version = 1
+)
+
+const maxLevelName = 256
+
+// MaxTicks is the longest recording read, an hour of play. Longer ones are
+// taken for corrupt rather than allocated.
+const MaxTicks = 60 * 60 * world.TicksPerSecond
+
+const (
+	bitLeft = 1 << iota
+	bitRight
+	bitJump
+	bitFire
+)
+
+var ErrFormat = errors.New("replay: not a replay file")
+
+// Replay is the recording of one level.
+type Replay struct {
+	Seed int64
+	// Level names the level played.
+	Level string
+	// Upgrades are the ones the level was played with.
+	Upgrades world.Upgrades
+	Inputs   []world.Input
+}
+
+func New(seed int64, level string, upgrades world.Upgrades) *Replay {
+	return &Replay{Seed: seed, Level: level, Upgrades: upgrades}
+}
+
+// Record appends the input of one tick.
+func (r *Replay) Record(in world.Input) {
+	r.Inputs = append(r.Inputs, in)
+}
+
+func (r *Replay) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(magic)
+	bw.WriteByte(version)
+	binary.Write(bw, binary.LittleEndian, r.Seed)
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	bw.Write(buf[:binary.PutUvarint(buf, uint64(len(r.Level)))])
+	bw.WriteString(r.Level)
+	for _, v := range []int{r.Upgrades.ExtraJumps, r.Upgrades.ProjectileSpeed, r.Upgrades.ExtraHealth} {
+		bw.Write(buf[:binary.PutUvarint(buf, uint64(v))])
+	}
+
+	type run struct {
+		length uint64
+		input  byte
+	}
+	var runs []run
+	for _, in := range r.Inputs {
+		b := encode(in)
+		if len(runs) > 0 && runs[len(runs)-1].input == b {
+			runs[len(runs)-1].length++
+			continue
+		}
+		runs = append(runs, run{length: 1, input: b})
+	}
+
+	bw.Write(buf[:binary.PutUvarint(buf, uint64(len(runs)))])
+	for _, run := range runs {
+		bw.Write(buf[:binary.PutUvarint(buf, run.length)])
+		bw.WriteByte(run.input)
+	}
+	return bw.Flush()
+}
+
+func Read(r io.Reader) (*Replay, error) {
+	br := bufio.NewReader(r)
+
+	head := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(br, head); err != nil {
+		return nil, ErrFormat
+	}
+	if string(head[:len(magic)]) != magic {
+		return nil, ErrFormat
+	}
+	v := head[len(magic)]
+	if v != version {
+		return nil, fmt.Errorf("replay: unsupported version %d", v)
+	}
+
+	rp := &Replay{}
+	if err := binary.Read(br, binary.LittleEndian, &rp.Seed); err != nil {
+		return nil, fmt.Errorf("replay: reading seed: %w", err)
+	}
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("replay: reading level name: %w", err)
+	}
+	if n > maxLevelName {
+		return nil, fmt.Errorf("replay: level name of %d bytes is too long", n)
+	}
+	name := make([]byte, n)
+	if _, err := io.ReadFull(br, name); err != nil {
+		return nil, fmt.Errorf("replay: reading level name: %w", err)
+	}
+	rp.Level = string(name)
+	for _, u := range []*int{&rp.Upgrades.ExtraJumps, &rp.Upgrades.ProjectileSpeed, &rp.Upgrades.ExtraHealth} {
+		n, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("replay: reading upgrades: %w", err)
+		}
+		*u = int(n)
+	}
+	runs, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("replay: reading run count: %w", err)
+	}
+	if runs > MaxTicks {
+		return nil, fmt.Errorf("replay: recording is longer than %d ticks", MaxTicks)
+	}
+	for i := uint64(0); i < runs; i++ {
+		length, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("replay: reading run %d: %w", i, err)
+		}
+		if length == 0 {
+			return nil, fmt.Errorf("replay: run %d is empty", i)
+		}
+		if length > MaxTicks-uint64(len(rp.Inputs)) {
+			return nil, fmt.Errorf("replay: recording is longer than %d ticks", MaxTicks)
+		}
+		b, err := br.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("replay: reading run %d: %w", i, err)
+		}
+		in := decode(b)
+		for j := uint64(0); j < length; j++ {
+			rp.Inputs = append(rp.Inputs, in)
+		}
+	}
+	return rp, nil
+}
+
+func (r *Replay) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := r.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func Load(path string) (*Replay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+func encode(in world.Input) byte {
+	var b byte
+	if in.Left {
+		b |= bitLeft
+	}
+	if in.Right {
+		b |= bitRight
+	}
+	if in.Jump {
+		b |= bitJump
+	}
+	if in.Fire {
+		b |= bitFire
+	}
+	return b
+}
+
+func decode(b byte) world.Input {
+	return world.Input{
+		Left:  b&bitLeft != 0,
+		Right: b&bitRight != 0,
+		Jump:  b&bitJump != 0,
+		Fire:  b&bitFire != 0,
+	}
+}
+
+// Player hands out the recorded inputs one tick at a time.
+type Player struct {
+	replay *Replay
+	tick   int
+}
+
+func NewPlayer(r *Replay) *Player {
+	return &Player{replay: r}
+}
+
+func (p *Player) Seed() int64 {
+	return p.replay.Seed
+}
+
+func (p *Player) Level() string {
+	return p.replay.Level
+}
+
+func (p *Player) Upgrades() world.Upgrades {
+	return p.replay.Upgrades
+}
+
+// Next returns the input for the next tick, or false once the recording
+// has run out.
+func (p *Player) Next() (world.Input, bool) {
+	if p.tick >= len(p.replay.Inputs) {
+		return world.Input{}, false
+	}
+	in := p.replay.Inputs[p.tick]
+	p.tick++
+	return in, true
+}
+
+func (p *Player) Rewind() {
+	p.tick = 0
+}
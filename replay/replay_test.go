@@ -0,0 +1,122 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mariuseis/go-inn/level"
+	"github.com/mariuseis/go-inn/world"
+)
+
+func inputs(n int) []world.Input {
+	r := world.Rand{State: 7}
+	var ins []world.Input
+	for i := 0; i < n; i++ {
+		// held keys change rarely, like in a real run
+		if i%10 == 0 || len(ins) == 0 {
+			ins = append(ins, world.Input{Left: r.Intn(4) == 0, Right: r.Intn(2) == 0, Jump: r.Intn(5) == 0, Fire: r.Intn(5) == 0})
+			continue
+		}
+		ins = append(ins, ins[len(ins)-1])
+	}
+	return ins
+}
+
+func TestRoundTrip(t *testing.T) {
+	want := New(-42, "meadow", world.Upgrades{ExtraJumps: 1, ProjectileSpeed: 6, ExtraHealth: 2})
+	for _, in := range inputs(500) {
+		want.Record(in)
+	}
+
+	var buf bytes.Buffer
+	if err := want.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("read %+v, wrote %+v", got, want)
+	}
+}
+
+// play runs a level with the inputs and returns the world as JSON.
+func play(t *testing.T, l *level.Level, seed int64, upgrades world.Upgrades, ins []world.Input) []byte {
+	w := world.New(l, seed, upgrades)
+	for _, in := range ins {
+		w.Step(in)
+	}
+	b, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	l, err := level.Builtin("meadow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upgrades := world.Upgrades{ProjectileSpeed: 6}
+	rec := New(1234, l.Name, upgrades)
+	for _, in := range inputs(2000) {
+		rec.Record(in)
+	}
+	want := play(t, l, rec.Seed, rec.Upgrades, rec.Inputs)
+
+	var buf bytes.Buffer
+	if err := rec.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	r, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewPlayer(r)
+	var ins []world.Input
+	for in, ok := p.Next(); ok; in, ok = p.Next() {
+		ins = append(ins, in)
+	}
+	if got := play(t, l, p.Seed(), p.Upgrades(), ins); !bytes.Equal(got, want) {
+		t.Error("the replay did not reproduce the recorded run")
+	}
+}
+
+func TestReadRejects(t *testing.T) {
+	header := func(runs uint64) *bytes.Buffer {
+		var buf bytes.Buffer
+		New(1, "meadow", world.Upgrades{}).Write(&buf)
+		// drop the zero run count and write another
+		buf.Truncate(buf.Len() - 1)
+		b := make([]byte, binary.MaxVarintLen64)
+		buf.Write(b[:binary.PutUvarint(b, runs)])
+		return &buf
+	}
+	run := func(buf *bytes.Buffer, length uint64) *bytes.Buffer {
+		b := make([]byte, binary.MaxVarintLen64)
+		buf.Write(b[:binary.PutUvarint(b, length)])
+		buf.WriteByte(0)
+		return buf
+	}
+
+	if _, err := Read(bytes.NewReader([]byte("NOTAREPLAY"))); !errors.Is(err, ErrFormat) {
+		t.Errorf("bad magic: got %v, want ErrFormat", err)
+	}
+	for name, buf := range map[string]*bytes.Buffer{
+		"too many runs":  header(MaxTicks + 1),
+		"too long a run": run(header(1), MaxTicks+1),
+		"too long":       run(run(header(2), MaxTicks), 1),
+		"empty run":      run(header(1), 0),
+		"truncated":      header(3),
+	} {
+		if _, err := Read(buf); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
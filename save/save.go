@@ -0,0 +1,77 @@
+// Package save stores a run in progress so it can be continued on the next
+// launch. The level being played is saved as the complete world state, so
+// continuing picks up at exactly the tick the game was left at.
+package save
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mariuseis/go-inn/persist"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// Version is the version of the save file.
+const Version = 1
+
+// FileName is the name of the save in the game's config directory.
+const FileName = "save.json"
+
+type Save struct {
+	Version int `json:"version"`
+	// Level names the level being played, or the one just completed when
+	// the run was saved in the inn.
+	Level string `json:"level"`
+	Seed  int64  `json:"seed"`
+	// Score and Coins are the run's totals from the levels completed
+	// before Level.
+	Score    world.Score    `json:"score"`
+	Coins    int            `json:"coins"`
+	Upgrades world.Upgrades `json:"upgrades"`
+	// World is the level in progress, nil when the run was saved in the
+	// inn.
+	World *world.World `json:"world,omitempty"`
+}
+
+func Read(r io.Reader) (*Save, error) {
+	var s Save
+	if err := persist.ReadJSON(r, Version, &s); err != nil {
+		return nil, fmt.Errorf("save: %w", err)
+	}
+	if s.Level == "" {
+		return nil, fmt.Errorf("save: no level")
+	}
+	return &s, nil
+}
+
+func (s *Save) Write(w io.Writer) error {
+	s.Version = Version
+	return persist.WriteJSON(w, s)
+}
+
+// Load reads the save at path. A missing save is reported with an error
+// satisfying errors.Is(err, os.ErrNotExist).
+func Load(path string) (*Save, error) {
+	var s *Save
+	err := persist.Load(path, func(r io.Reader) (err error) {
+		s, err = Read(r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Save) Save(path string) error {
+	return persist.Save(path, s.Write)
+}
+
+// Remove deletes the save at path, if there is one.
+func Remove(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
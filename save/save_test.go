@@ -0,0 +1,126 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mariuseis/go-inn/level"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// inputs is a fixed pattern of inputs for tick i.
+func inputs(i int) world.Input {
+	return world.Input{Right: i%7 != 0, Left: i%7 == 0, Jump: i%45 == 0, Fire: i%25 == 0}
+}
+
+func marshal(t *testing.T, w *world.World) []byte {
+	b, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRoundTrip(t *testing.T) {
+	l, err := level.Builtin("meadow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := world.New(l, 42, world.Upgrades{ExtraJumps: 1})
+	for i := 0; i < 300; i++ {
+		w.Step(inputs(i))
+	}
+
+	s := &Save{
+		Level:    l.Name,
+		Seed:     42,
+		Score:    world.Score{Coins: 30, Kills: 100, Time: 200},
+		Coins:    3,
+		Upgrades: world.Upgrades{ExtraJumps: 1},
+		World:    w,
+	}
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := s.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != Version || got.Level != s.Level || got.Seed != s.Seed || got.Score != s.Score ||
+		got.Coins != s.Coins || got.Upgrades != s.Upgrades {
+		t.Errorf("got %+v\nwant %+v", got, s)
+	}
+
+	// the restored world carries on exactly like the one saved
+	for i := 300; i < 900; i++ {
+		a, b := w.Step(inputs(i)), got.World.Step(inputs(i))
+		if !reflect.DeepEqual(a, b) {
+			t.Fatalf("tick %d: events %v, restored %v", i, a, b)
+		}
+	}
+	if a, b := marshal(t, w), marshal(t, got.World); string(a) != string(b) {
+		t.Error("the restored world ended up different")
+	}
+}
+
+func TestRoundTripInn(t *testing.T) {
+	s := &Save{Level: "meadow", Seed: 1}
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := s.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.World != nil {
+		t.Error("a save made in the inn has a world")
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Load(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing file: %v, want os.ErrNotExist", err)
+	}
+
+	for _, c := range []struct {
+		name, data string
+	}{
+		{"corrupt", `{"version": 1, "level": "mea`},
+		{"too new", `{"version": 2, "level": "meadow"}`},
+		{"no level", `{"version": 1, "seed": 3}`},
+	} {
+		path := filepath.Join(dir, c.name+".json")
+		if err := os.WriteFile(path, []byte(c.data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		s, err := Load(path)
+		if err == nil || errors.Is(err, os.ErrNotExist) {
+			t.Errorf("%s: error %v", c.name, err)
+		}
+		if s != nil {
+			t.Errorf("%s: loaded %+v", c.name, s)
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := (&Save{Level: "meadow"}).Save(path); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := Remove(path); err != nil {
+			t.Errorf("remove %d: %v", i, err)
+		}
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("save still there: %v", err)
+	}
+}
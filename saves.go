@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+
+	"github.com/mariuseis/go-inn/save"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// loadSave returns the run saved at path, or nil when there is none that
+// can be continued.
+func loadSave(path string) *save.Save {
+	if path == "" {
+		return nil
+	}
+	s, err := save.Load(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("loading saved game: %v", err)
+		}
+		return nil
+	}
+	return s
+}
+
+// saveRun stores the run in progress so it can be continued later and
+// returns it. Runs that have ended, and replays, are not saved and nil is
+// returned; the save of a run that has ended is removed.
+func (g *Game) saveRun() *save.Save {
+	if g.playback != nil {
+		return nil
+	}
+	s := &save.Save{
+		Level:    g.level().Name,
+		Seed:     g.seed,
+		Score:    g.runScore,
+		Coins:    g.coins,
+		Upgrades: g.upgrades,
+	}
+	switch mode := g.underlyingMode(); {
+	case mode == ModeGame || mode == ModePause:
+		s.World = g.world
+	case mode == ModeInn:
+	case mode == ModeLevelComplete && g.levelIndex+1 < len(g.options.Levels):
+		// the level is banked, continue in the inn
+	case mode == ModeTitle:
+		// nothing is being played, the save offered stays
+		return nil
+	default:
+		// the run is over, it may have been continued from the save
+		g.discardSave()
+		return nil
+	}
+	if g.options.SavePath == "" {
+		return s
+	}
+	if err := s.Save(g.options.SavePath); err != nil {
+		log.Printf("saving game: %v", err)
+	}
+	return s
+}
+
+// underlyingMode is the mode the options and controls screens return to
+// when one of them is showing, the current mode otherwise.
+func (g *Game) underlyingMode() Mode {
+	mode := g.mode
+	if mode == ModeControls {
+		mode = g.controlsScreen.back
+	}
+	if mode == ModeOptions {
+		mode = g.optionsBack
+	}
+	return mode
+}
+
+// discardSave forgets the saved run, once it has been continued past or
+// replaced by a new one.
+func (g *Game) discardSave() {
+	g.saved = nil
+	if g.options.SavePath == "" || g.playback != nil {
+		return
+	}
+	if err := save.Remove(g.options.SavePath); err != nil {
+		log.Printf("removing saved game: %v", err)
+	}
+}
+
+// continueRun restores the saved run. It reports false when the saved
+// level is not among the levels being played.
+func (g *Game) continueRun(s *save.Save) bool {
+	index, ok := levelByName(g.options.Levels, s.Level)
+	if !ok {
+		log.Printf("saved game: level %q is not being played", s.Level)
+		return false
+	}
+
+	g.levelIndex = index
+	g.seed = s.Seed
+	g.runScore = s.Score
+	g.coins = s.Coins
+	g.upgrades = s.Upgrades
+	// A recording has to start at the beginning of a level.
+	g.recording = nil
+	if s.World == nil {
+		g.world = world.New(g.level(), g.seed, g.upgrades)
+		g.enterInn()
+		return true
+	}
+	g.world = s.World
+	g.mode = ModeGame
+	return true
+}
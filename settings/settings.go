@@ -0,0 +1,111 @@
+// Package settings holds the player's audio and video options, kept
+// between runs in the game's config directory.
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mariuseis/go-inn/persist"
+)
+
+const (
+	// Version is the version of the settings file.
+	Version = 1
+	// MaxVolume is a volume at full loudness, VolumeStep what the options
+	// screen changes a volume by.
+	MaxVolume  = 100
+	VolumeStep = 10
+	// MaxWindowScale is the largest window size, in multiples of the
+	// screen size.
+	MaxWindowScale = 3
+)
+
+// FileName is the name of the settings in the game's config directory.
+const FileName = "settings.json"
+
+type Settings struct {
+	Version int `json:"version"`
+
+	// Volumes go from 0 to MaxVolume. The music and effect volumes are
+	// scaled by the master volume.
+	MasterVolume int `json:"masterVolume"`
+	MusicVolume  int `json:"musicVolume"`
+	SFXVolume    int `json:"sfxVolume"`
+	// Mute silences everything without touching the volumes.
+	Mute bool `json:"mute"`
+
+	Fullscreen  bool `json:"fullscreen"`
+	WindowScale int  `json:"windowScale"`
+	Vsync       bool `json:"vsync"`
+	// Debug shows the TPS and player position overlay.
+	Debug bool `json:"debug"`
+}
+
+func Default() Settings {
+	return Settings{
+		Version:      Version,
+		MasterVolume: MaxVolume,
+		MusicVolume:  80,
+		SFXVolume:    MaxVolume,
+		WindowScale:  1,
+		Vsync:        true,
+	}
+}
+
+// clamp brings every value into its range.
+func (s *Settings) clamp() {
+	s.MasterVolume = clamp(s.MasterVolume, 0, MaxVolume)
+	s.MusicVolume = clamp(s.MusicVolume, 0, MaxVolume)
+	s.SFXVolume = clamp(s.SFXVolume, 0, MaxVolume)
+	s.WindowScale = clamp(s.WindowScale, 1, MaxWindowScale)
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// Read decodes settings. Settings missing from the file keep their
+// defaults and values out of range are brought into it.
+func Read(r io.Reader) (Settings, error) {
+	s := Default()
+	if err := persist.ReadJSON(r, Version, &s); err != nil {
+		return Default(), fmt.Errorf("settings: %w", err)
+	}
+	s.clamp()
+	return s, nil
+}
+
+func (s Settings) Write(w io.Writer) error {
+	s.Version = Version
+	return persist.WriteJSON(w, s)
+}
+
+// Load reads the settings at path, falling back to the defaults when
+// there is no file or it can't be used.
+func Load(path string) (Settings, error) {
+	s := Default()
+	err := persist.Load(path, func(r io.Reader) (err error) {
+		s, err = Read(r)
+		return err
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return Default(), nil
+	}
+	if err != nil {
+		return Default(), err
+	}
+	return s, nil
+}
+
+func (s Settings) Save(path string) error {
+	return persist.Save(path, s.Write)
+}
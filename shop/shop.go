@@ -0,0 +1,82 @@
+// Package shop is the innkeeper's stock: upgrades bought with the coins
+// collected on the road.
+package shop
+
+import (
+	"errors"
+
+	"github.com/mariuseis/go-inn/world"
+)
+
+var (
+	ErrNotEnoughCoins = errors.New("not enough coins")
+	ErrSoldOut        = errors.New("sold out")
+)
+
+type Item struct {
+	Name string
+	// BasePrice is the price of the first one, every further one costs
+	// BasePrice more.
+	BasePrice int
+	// Max is how many of the item can be bought in one run.
+	Max int
+
+	owned func(u world.Upgrades) int
+	apply func(u *world.Upgrades)
+}
+
+// Items is the stock in the order it is shown.
+var Items = []Item{
+	{
+		Name:      "EXTRA JUMP",
+		BasePrice: 10,
+		Max:       2,
+		owned:     func(u world.Upgrades) int { return u.ExtraJumps },
+		apply:     func(u *world.Upgrades) { u.ExtraJumps++ },
+	},
+	{
+		Name:      "FASTER SHOTS",
+		BasePrice: 5,
+		Max:       3,
+		owned:     func(u world.Upgrades) int { return u.ProjectileSpeed / projectileSpeedStep },
+		apply:     func(u *world.Upgrades) { u.ProjectileSpeed += projectileSpeedStep },
+	},
+	{
+		Name:      "MORE HEALTH",
+		BasePrice: 8,
+		Max:       3,
+		owned:     func(u world.Upgrades) int { return u.ExtraHealth },
+		apply:     func(u *world.Upgrades) { u.ExtraHealth++ },
+	},
+}
+
+// projectileSpeedStep is the speed each FASTER SHOTS adds.
+const projectileSpeedStep = 2
+
+// Owned returns how many of the item the upgrades already include.
+func (it Item) Owned(u world.Upgrades) int {
+	return it.owned(u)
+}
+
+// Price returns what the next one costs.
+func (it Item) Price(u world.Upgrades) int {
+	return it.BasePrice * (it.owned(u) + 1)
+}
+
+func (it Item) SoldOut(u world.Upgrades) bool {
+	return it.owned(u) >= it.Max
+}
+
+// Buy pays for the item from coins and adds it to the upgrades.
+func (it Item) Buy(coins *int, u *world.Upgrades) error {
+	if it.SoldOut(*u) {
+		return ErrSoldOut
+	}
+	price := it.Price(*u)
+	if *coins < price {
+		return ErrNotEnoughCoins
+	}
+	*coins -= price
+	it.apply(u)
+	return nil
+}
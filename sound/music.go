@@ -0,0 +1,138 @@
+package sound
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+)
+
+const (
+	// CrossfadeTicks is how long a track takes to fade into the next.
+	CrossfadeTicks = 60
+	// DuckVolume is what the music is turned down to while effects play,
+	// DuckTicks how long turning it down or back up takes.
+	DuckVolume = 0.5
+	DuckTicks  = 10
+)
+
+// trackSource opens a track's audio from the beginning, returning the
+// stream and its length in bytes.
+type trackSource func() (io.ReadSeeker, int64, error)
+
+// voice is a track that is playing, fading in or out.
+type voice struct {
+	name   string
+	player *audio.Player
+	gain   float64
+}
+
+// AddMusicOgg adds an Ogg Vorbis music track. It is decoded while it plays
+// rather than up front.
+func (m *Manager) AddMusicOgg(name string, data []byte) {
+	m.tracks[name] = func() (io.ReadSeeker, int64, error) {
+		s, err := vorbis.Decode(m.ctx, bytes.NewReader(data))
+		if err != nil {
+			return nil, 0, err
+		}
+		return s, s.Length(), nil
+	}
+}
+
+// AddMusicPCM adds a music track given as 16 bit stereo PCM at SampleRate.
+func (m *Manager) AddMusicPCM(name string, pcm []byte) {
+	m.tracks[name] = func() (io.ReadSeeker, int64, error) {
+		return bytes.NewReader(pcm), int64(len(pcm)), nil
+	}
+}
+
+// HasMusic reports whether there is a track with the given name.
+func (m *Manager) HasMusic(name string) bool {
+	_, ok := m.tracks[name]
+	return ok
+}
+
+// PlayMusic crossfades from the current track to the named one, which
+// loops until another one is played. An empty name fades the music out.
+// Playing the current track again does nothing.
+func (m *Manager) PlayMusic(name string) error {
+	if m.music != nil && m.music.name == name || m.music == nil && name == "" {
+		return nil
+	}
+	if m.music != nil {
+		m.fading = append(m.fading, m.music)
+		m.music = nil
+	}
+	if name == "" {
+		return nil
+	}
+	open, ok := m.tracks[name]
+	if !ok {
+		return fmt.Errorf("sound: no music track %q", name)
+	}
+	src, length, err := open()
+	if err != nil {
+		return fmt.Errorf("sound: music track %q: %w", name, err)
+	}
+	p, err := audio.NewPlayer(m.ctx, audio.NewInfiniteLoop(src, length))
+	if err != nil {
+		return fmt.Errorf("sound: music track %q: %w", name, err)
+	}
+	m.music = &voice{name: name, player: p}
+	m.setMusicVolume(m.music)
+	p.Play()
+	return nil
+}
+
+// CurrentMusic returns the name of the track playing, or "".
+func (m *Manager) CurrentMusic() string {
+	if m.music == nil {
+		return ""
+	}
+	return m.music.name
+}
+
+// updateMusic advances the crossfade and the ducking by one tick.
+func (m *Manager) updateMusic() {
+	duck := 1.0
+	if len(m.playing) > 0 {
+		duck = DuckVolume
+	}
+	m.duck = approach(m.duck, duck, (1-DuckVolume)/DuckTicks)
+
+	if m.music != nil {
+		m.music.gain = approach(m.music.gain, 1, 1.0/CrossfadeTicks)
+		m.setMusicVolume(m.music)
+	}
+	fading := m.fading[:0]
+	for _, v := range m.fading {
+		v.gain = approach(v.gain, 0, 1.0/CrossfadeTicks)
+		if v.gain == 0 {
+			v.player.Close()
+			continue
+		}
+		m.setMusicVolume(v)
+		fading = append(fading, v)
+	}
+	for i := len(fading); i < len(m.fading); i++ {
+		m.fading[i] = nil
+	}
+	m.fading = fading
+}
+
+func (m *Manager) setMusicVolume(v *voice) {
+	v.player.SetVolume(v.gain * m.duck * m.Volume(Music))
+}
+
+// approach moves v towards target by at most step.
+func approach(v, target, step float64) float64 {
+	switch {
+	case v < target-step:
+		return v + step
+	case v > target+step:
+		return v - step
+	}
+	return target
+}
@@ -0,0 +1,179 @@
+// Package sound plays the game's audio. Every sound is decoded once, when
+// it is loaded, and played through one of the mixing buses, whose volumes
+// multiply with the master bus.
+package sound
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+)
+
+// SampleRate is the rate all sounds are decoded and played at.
+const SampleRate = 48000
+
+// Bus is a group of sounds whose volume is set together.
+type Bus int
+
+const (
+	Master Bus = iota
+	Music
+	SFX
+
+	numBuses
+)
+
+type bus struct {
+	volume float64
+	muted  bool
+}
+
+// Manager owns the audio context, the decoded sounds and the players of
+// the effects still sounding, and plays the music.
+type Manager struct {
+	ctx    *audio.Context
+	sounds map[string][]byte
+	buses  [numBuses]bus
+
+	playing []*audio.Player
+
+	tracks map[string]trackSource
+	// music is the track playing, fading contains the tracks being faded
+	// out. duck scales the music down while effects play.
+	music  *voice
+	fading []*voice
+	duck   float64
+}
+
+func New() *Manager {
+	m := &Manager{
+		ctx:    audio.NewContext(SampleRate),
+		sounds: map[string][]byte{},
+		tracks: map[string]trackSource{},
+		duck:   1,
+	}
+	for i := range m.buses {
+		m.buses[i].volume = 1
+	}
+	return m
+}
+
+// Context is the audio context the manager plays through.
+func (m *Manager) Context() *audio.Context {
+	return m.ctx
+}
+
+// LoadPCM adds a sound given as 16 bit stereo PCM at SampleRate.
+func (m *Manager) LoadPCM(name string, pcm []byte) {
+	m.sounds[name] = pcm
+}
+
+// LoadOgg decodes an Ogg Vorbis file and adds it under name.
+func (m *Manager) LoadOgg(name string, data []byte) error {
+	s, err := vorbis.Decode(m.ctx, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("sound %q: %w", name, err)
+	}
+	return m.load(name, s)
+}
+
+// LoadWav decodes a WAV file and adds it under name.
+func (m *Manager) LoadWav(name string, data []byte) error {
+	s, err := wav.Decode(m.ctx, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("sound %q: %w", name, err)
+	}
+	return m.load(name, s)
+}
+
+func (m *Manager) load(name string, r io.Reader) error {
+	pcm, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("sound %q: %w", name, err)
+	}
+	m.LoadPCM(name, pcm)
+	return nil
+}
+
+// Play starts the named sound on the effects bus. Playing a sound that is
+// still sounding starts another instance of it on top.
+func (m *Manager) Play(name string) {
+	pcm, ok := m.sounds[name]
+	if !ok {
+		return
+	}
+	p := audio.NewPlayerFromBytes(m.ctx, pcm)
+	p.SetVolume(m.Volume(SFX))
+	p.Play()
+	m.playing = append(m.playing, p)
+}
+
+// Update forgets the effects that have finished and moves the music's
+// fades along. It is called once per tick.
+func (m *Manager) Update() {
+	playing := m.playing[:0]
+	for _, p := range m.playing {
+		if p.IsPlaying() {
+			playing = append(playing, p)
+			continue
+		}
+		p.Close()
+	}
+	for i := len(playing); i < len(m.playing); i++ {
+		m.playing[i] = nil
+	}
+	m.playing = playing
+
+	m.updateMusic()
+}
+
+// Playing reports how many effects are sounding.
+func (m *Manager) Playing() int {
+	return len(m.playing)
+}
+
+// SetVolume sets a bus's own volume, in [0, 1].
+func (m *Manager) SetVolume(b Bus, volume float64) {
+	m.buses[b].volume = volume
+	m.applyVolumes()
+}
+
+// SetMuted silences a bus, or lets it be heard again, without changing its
+// volume.
+func (m *Manager) SetMuted(b Bus, muted bool) {
+	m.buses[b].muted = muted
+	m.applyVolumes()
+}
+
+// Volume returns the volume a sound on bus b is played at, taking the
+// master bus and muting into account.
+func (m *Manager) Volume(b Bus) float64 {
+	v := m.level(b)
+	if b != Master {
+		v *= m.level(Master)
+	}
+	return v
+}
+
+func (m *Manager) level(b Bus) float64 {
+	if m.buses[b].muted {
+		return 0
+	}
+	return m.buses[b].volume
+}
+
+func (m *Manager) applyVolumes() {
+	for _, p := range m.playing {
+		p.SetVolume(m.Volume(SFX))
+	}
+	if m.music != nil {
+		m.setMusicVolume(m.music)
+	}
+	for _, v := range m.fading {
+		m.setMusicVolume(v)
+	}
+}
@@ -0,0 +1,39 @@
+package sound
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Tone is one note of a synthesized sound. A zero Freq is a rest.
+type Tone struct {
+	Freq     float64
+	Duration float64
+}
+
+// Synthesize renders square wave notes, one after the other and each
+// fading out, as 16 bit stereo PCM at the given sample rate. It gives the
+// game sounds for which there is no recording.
+func Synthesize(sampleRate int, volume float64, tones ...Tone) []byte {
+	var pcm []byte
+	for _, t := range tones {
+		n := int(t.Duration * float64(sampleRate))
+		for i := 0; i < n; i++ {
+			phase := math.Mod(float64(i)*t.Freq/float64(sampleRate), 1)
+			v := volume
+			if phase >= 0.5 {
+				v = -v
+			}
+			if t.Freq == 0 {
+				v = 0
+			}
+			v *= 1 - float64(i)/float64(n)
+			s := int16(v * math.MaxInt16)
+			var b [4]byte
+			binary.LittleEndian.PutUint16(b[0:], uint16(s))
+			binary.LittleEndian.PutUint16(b[2:], uint16(s))
+			pcm = append(pcm, b[:]...)
+		}
+	}
+	return pcm
+}
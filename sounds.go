@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"path"
+	"strings"
+
+	"github.com/mariuseis/go-inn/assets"
+	"github.com/mariuseis/go-inn/settings"
+	"github.com/mariuseis/go-inn/sound"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// The sound effects gameplay plays by name.
+const (
+	sfxJump   = "jump"
+	sfxShoot  = "shoot"
+	sfxHit    = "hit"
+	sfxPickup = "pickup"
+	sfxLand   = "land"
+	sfxDeath  = "death"
+)
+
+// loadSounds fills the effects bank and adds the music tracks from the
+// asset manifest. Effects there is no recording of are synthesized. Every
+// sound is loaded, the error lists all that failed.
+func loadSounds(m *sound.Manager) error {
+	var problems []string
+	for _, s := range catalog.Manifest.Sounds {
+		b, _, ok := catalog.Sound(s.ID)
+		if !ok {
+			continue
+		}
+		var err error
+		switch {
+		case s.Music:
+			m.AddMusicOgg(s.ID, b)
+		case strings.EqualFold(path.Ext(s.File), ".wav"):
+			err = m.LoadWav(s.ID, b)
+		default:
+			err = m.LoadOgg(s.ID, b)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("sound %q: %v", s.ID, err))
+		}
+	}
+
+	m.LoadPCM(sfxShoot, sound.Synthesize(sound.SampleRate, 0.1, sound.Tone{Freq: 1568, Duration: 0.03}, sound.Tone{Freq: 784, Duration: 0.06}))
+	m.LoadPCM(sfxPickup, sound.Synthesize(sound.SampleRate, 0.15, sound.Tone{Freq: 988, Duration: 0.06}, sound.Tone{Freq: 1319, Duration: 0.12}))
+	m.LoadPCM(sfxLand, sound.Synthesize(sound.SampleRate, 0.2, sound.Tone{Freq: 98, Duration: 0.05}))
+	m.LoadPCM(sfxDeath, sound.Synthesize(sound.SampleRate, 0.15,
+		sound.Tone{Freq: 523, Duration: 0.12}, sound.Tone{Freq: 392, Duration: 0.12}, sound.Tone{Freq: 262, Duration: 0.35}))
+	if len(problems) > 0 {
+		return &assets.Error{Problems: problems}
+	}
+	return nil
+}
+
+// The music tracks. Levels can pick one of them, or any other track in the
+// asset manifest, in their "music" field.
+const (
+	musicRagtime  = "ragtime"
+	musicTitle    = "title"
+	musicGameOver = "gameover"
+)
+
+// loadMusic adds the synthesized tunes. Ragtime comes from the asset
+// manifest.
+func loadMusic(m *sound.Manager) {
+	m.AddMusicPCM(musicTitle, tune(0.18, 0.06,
+		72, 76, 79, 84, 79, 76, 72, 0,
+		74, 77, 81, 86, 81, 77, 74, 0,
+		71, 74, 79, 83, 79, 74, 71, 0,
+		72, 76, 79, 84, 0, 84, 0, 0))
+	m.AddMusicPCM(musicGameOver, tune(0.45, 0.06,
+		69, 72, 76, 72,
+		67, 71, 74, 71,
+		65, 69, 72, 69,
+		64, 68, 71, 0))
+}
+
+// tune synthesizes notes of equal length given as MIDI note numbers, 0
+// being a rest.
+func tune(noteSeconds, volume float64, notes ...int) []byte {
+	var tones []sound.Tone
+	for _, n := range notes {
+		t := sound.Tone{Duration: noteSeconds}
+		if n > 0 {
+			t.Freq = 440 * math.Pow(2, float64(n-69)/12)
+		}
+		tones = append(tones, t)
+	}
+	return sound.Synthesize(sound.SampleRate, volume, tones...)
+}
+
+// modeMusic returns the track for the current mode. Modes without a track
+// of their own, like the pause and options screens, keep the one playing.
+func (g *Game) modeMusic() (string, bool) {
+	switch g.mode {
+	case ModeTitle, ModeEnding:
+		return musicTitle, true
+	case ModeGame:
+		if m := g.level().Music; m != "" {
+			return m, true
+		}
+		return musicRagtime, true
+	case ModeInn:
+		return musicRagtime, true
+	case ModeGameOver:
+		return musicGameOver, true
+	}
+	return "", false
+}
+
+// updateMusic crossfades to the current mode's track when it changed.
+func (g *Game) updateMusic() {
+	name, ok := g.modeMusic()
+	if !ok || name == g.sound.CurrentMusic() {
+		return
+	}
+	if err := g.sound.PlayMusic(name); err != nil {
+		log.Print(err)
+	}
+}
+
+// eventSounds are the effects played for world events.
+var eventSounds = map[world.Event]string{
+	world.EventJump:       sfxJump,
+	world.EventShoot:      sfxShoot,
+	world.EventEnemyHit:   sfxHit,
+	world.EventPlayerHurt: sfxHit,
+	world.EventCoinPickup: sfxPickup,
+	world.EventLand:       sfxLand,
+	world.EventDeath:      sfxDeath,
+}
+
+// applyVolumes sets the mixing buses from the settings.
+func (g *Game) applyVolumes() {
+	s := g.settings
+	g.sound.SetVolume(sound.Master, float64(s.MasterVolume)/settings.MaxVolume)
+	g.sound.SetVolume(sound.Music, float64(s.MusicVolume)/settings.MaxVolume)
+	g.sound.SetVolume(sound.SFX, float64(s.SFXVolume)/settings.MaxVolume)
+	g.sound.SetMuted(sound.Master, s.Mute)
+}
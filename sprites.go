@@ -0,0 +1,153 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/mariuseis/go-inn/anim"
+	"github.com/mariuseis/go-inn/world"
+)
+
+// actorAnimations are the animations the actor sheets have to provide.
+var actorAnimations = []string{anim.Idle, anim.Run, anim.Jump, anim.Fall, anim.Shoot, anim.Hurt, anim.Die}
+
+// The layouts of the actor sheets. They are changed in place when a sheet
+// is reloaded, so the animations playing carry on with it.
+var (
+	playerSheet = &anim.Sheet{}
+	enemySheet  = &anim.Sheet{}
+)
+
+// loadSheets lays out the actor sheets from their manifest entries.
+func loadSheets() {
+	layOutSheet(playerSheet, imagePlayerSheet)
+	layOutSheet(enemySheet, imageEnemySheet)
+}
+
+// layOutSheet sets s to the frame grid and animations the manifest gives
+// the image.
+func layOutSheet(s *anim.Sheet, id string) {
+	info, _ := catalog.ImageInfo(id)
+	img := catalog.Image(id)
+	*s = anim.Sheet{Animations: map[string]*anim.Animation{}}
+	if img == nil || info.FrameWidth == 0 {
+		return
+	}
+	s.FrameWidth, s.FrameHeight = info.FrameWidth, info.FrameHeight
+	s.Columns = img.Bounds().Dx() / info.FrameWidth
+	for name, a := range info.Animations {
+		animation := &anim.Animation{Loop: a.Loop}
+		for i, index := range a.Frames {
+			animation.Frames = append(animation.Frames, anim.Frame{Index: index, Ticks: a.FrameTicks(i)})
+		}
+		s.Animations[name] = animation
+	}
+}
+
+// enemyAnim is the animation of one enemy and what it is picked from.
+type enemyAnim struct {
+	*anim.Machine
+	health    int
+	hurtTicks int
+	// Where the enemy was last seen, for playing its death once it is
+	// gone from the world.
+	x, y int
+	flip bool
+}
+
+// hurtAnimTicks is how long the hurt animation plays after a hit.
+const hurtAnimTicks = 16
+
+// resetAnimations starts the animations over for a new world.
+func (g *Game) resetAnimations() {
+	g.animWorld = g.world
+	g.playerAnim = anim.NewMachine(playerSheet)
+	g.enemyAnims = map[world.ID]*enemyAnim{}
+	g.corpses = nil
+}
+
+// updateAnimations advances every animation by a tick, from the world's
+// state after the tick's step and the events it produced.
+func (g *Game) updateAnimations(events []world.Event) {
+	if g.animWorld != g.world {
+		g.resetAnimations()
+	}
+
+	p := g.world.Player
+	for _, e := range events {
+		if e == world.EventShoot {
+			g.playerAnim.Trigger(anim.Shoot)
+		}
+	}
+	g.playerAnim.Update(anim.State{
+		OnGround: p.OnGround,
+		VX:       p.VX16,
+		VY:       p.VY16,
+		Hurt:     p.Knockback > 0,
+		Dead:     p.Health == 0,
+	})
+
+	seen := map[world.ID]bool{}
+	for _, e := range g.world.Entities {
+		if e.Sprite == nil || e.Sprite.Image != "enemy" || e.Health == nil {
+			continue
+		}
+		seen[e.ID] = true
+		a := g.enemyAnims[e.ID]
+		if a == nil {
+			a = &enemyAnim{Machine: anim.NewMachine(enemySheet), health: e.Health.Current}
+			g.enemyAnims[e.ID] = a
+		}
+		if e.Health.Current < a.health {
+			a.hurtTicks = hurtAnimTicks
+		}
+		if a.hurtTicks > 0 {
+			a.hurtTicks--
+		}
+		a.health = e.Health.Current
+		a.x, a.y, a.flip = e.Position.X, e.Position.Y, e.Sprite.FlipX
+		vx := 0
+		if e.Velocity != nil {
+			vx = e.Velocity.X
+		}
+		a.Update(anim.State{OnGround: true, VX: vx, Hurt: a.hurtTicks > 0})
+	}
+
+	corpses := g.corpses[:0]
+	for _, c := range g.corpses {
+		c.Step()
+		if !c.Done() {
+			corpses = append(corpses, c)
+		}
+	}
+	g.corpses = corpses
+
+	// enemies that are gone were killed, they stay to play their death
+	for id, a := range g.enemyAnims {
+		if !seen[id] {
+			delete(g.enemyAnims, id)
+			a.Restart(anim.Die)
+			g.corpses = append(g.corpses, a)
+		}
+	}
+}
+
+// drawFrame draws the current frame of an actor sheet so that the actor,
+// of size w x h, has its top left corner at x, y on screen. Actors stand on
+// the middle of the bottom edge of their frames.
+func drawFrame(screen, sheet *ebiten.Image, p *anim.Player, x, y, w, h int, flip bool, op *ebiten.DrawImageOptions) {
+	s := p.Sheet
+	frame := sheet.SubImage(s.FrameRect(p.Frame())).(*ebiten.Image)
+	if flip {
+		op.GeoM.Scale(-1, 1)
+		op.GeoM.Translate(float64(s.FrameWidth), 0)
+	}
+	op.GeoM.Translate(float64(x-(s.FrameWidth-w)/2), float64(y-(s.FrameHeight-h)))
+	screen.DrawImage(frame, op)
+}
+
+func (g *Game) drawCorpses(screen *ebiten.Image) {
+	for _, c := range g.corpses {
+		drawFrame(screen, assetImage(imageEnemySheet), &c.Player, c.x-g.world.CameraX, c.y-g.world.CameraY,
+			world.PlayerWidth, world.PlayerHeight, c.flip, &ebiten.DrawImageOptions{})
+	}
+}
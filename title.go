@@ -0,0 +1,50 @@
+package main
+
+const (
+	titleStart    = "START"
+	titleContinue = "CONTINUE"
+	titleNewGame  = "NEW GAME"
+	titleOptions  = "OPTIONS"
+)
+
+// titleItems returns the title menu, which offers to continue when there
+// is a saved run.
+func (g *Game) titleItems() []string {
+	if g.saved != nil {
+		return []string{titleContinue, titleNewGame, titleOptions}
+	}
+	return []string{titleStart, titleOptions}
+}
+
+func (g *Game) updateTitle() {
+	if g.playback != nil {
+		g.mode = ModeGame
+		return
+	}
+	g.titleMenu.items = g.titleItems()
+	n := g.menuNav()
+	// any gamepad button picks the highlighted item, which is START
+	// unless moved
+	n.confirm = n.confirm || g.pads.AnyButtonJustPressed()
+	i := g.titleMenu.update(n)
+	if i < 0 {
+		return
+	}
+	switch g.titleMenu.items[i] {
+	case titleStart:
+		g.mode = ModeGame
+	case titleContinue:
+		s := g.saved
+		g.saved = nil
+		g.titleMenu.cursor = 0
+		if !g.continueRun(s) {
+			g.discardSave()
+		}
+	case titleNewGame:
+		g.discardSave()
+		g.titleMenu.cursor = 0
+		g.mode = ModeGame
+	case titleOptions:
+		g.openOptions()
+	}
+}
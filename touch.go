@@ -0,0 +1,62 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+
+	"github.com/mariuseis/go-inn/input"
+)
+
+var (
+	touchButtonColor     = color.RGBA{0xff, 0xff, 0xff, 0x30}
+	touchButtonHeldColor = color.RGBA{0xff, 0xff, 0xff, 0x70}
+)
+
+func readTouches() []input.Touch {
+	var touches []input.Touch
+	for _, id := range ebiten.TouchIDs() {
+		x, y := ebiten.TouchPosition(id)
+		touches = append(touches, input.Touch{ID: int(id), X: x, Y: y})
+	}
+	return touches
+}
+
+// updateTouches reads the fingers on the screen and returns the actions
+// held by them. The on-screen buttons are shown from the first touch on.
+func (g *Game) updateTouches() input.Set {
+	touches := readTouches()
+	if len(touches) > 0 {
+		g.touchScreen = true
+	}
+	return g.touchLayout.Actions(touches)
+}
+
+// justTapped returns where a finger was put down this tick.
+func justTapped() (x, y int, ok bool) {
+	ids := inpututil.JustPressedTouchIDs()
+	if len(ids) == 0 {
+		return 0, 0, false
+	}
+	x, y = ebiten.TouchPosition(ids[0])
+	return x, y, true
+}
+
+func (g *Game) drawTouchControls(screen *ebiten.Image) {
+	if !g.touchScreen {
+		return
+	}
+	for _, b := range g.touchLayout.Buttons {
+		c := touchButtonColor
+		if g.controls.Pressed(b.Action) {
+			c = touchButtonHeldColor
+		}
+		ebitenutil.DrawRect(screen, float64(b.X), float64(b.Y), float64(b.W), float64(b.H), c)
+		x := b.X + (b.W-len(b.Label)*smallFontSize)/2
+		y := b.Y + (b.H+smallFontSize)/2
+		text.Draw(screen, b.Label, smallArcadeFont, x, y, color.White)
+	}
+}
@@ -0,0 +1,70 @@
+package world
+
+import "github.com/mariuseis/go-inn/collision"
+
+// GroundID is the body ID of the ground in contacts. Entity IDs start at 1.
+const GroundID = 0
+
+// ground is the floor running along the bottom of every level.
+var ground = collision.Body{
+	ID:   GroundID,
+	Rect: collision.Rect{X: -1 << 20, Y: ScreenHeight - TileSize, W: 1 << 21, H: TileSize},
+}
+
+func (w *World) playerRect() collision.Rect {
+	return collision.Rect{X: w.Player.X16, Y: w.Player.Y16, W: PlayerWidth, H: PlayerHeight}
+}
+
+func entityRect(e *Entity) collision.Rect {
+	return collision.Rect{X: e.Position.X, Y: e.Position.Y, W: e.Collider.Width, H: e.Collider.Height}
+}
+
+// query returns the colliders overlapping area whose entities pass the
+// filter. All collision checks go through the spatial hash here.
+func (w *World) query(area collision.Rect, filter func(*Entity) bool) []collision.Body {
+	w.ensureIndex()
+	var bodies []collision.Body
+	for _, b := range w.grid.Query(area) {
+		if e := w.byID[ID(b.ID)]; !e.Removed && filter(e) {
+			bodies = append(bodies, b)
+		}
+	}
+	return bodies
+}
+
+// solidsAround returns the solids, including the ground, that a rectangle
+// moving by dx, dy could run into.
+func (w *World) solidsAround(r collision.Rect, dx, dy int) []collision.Body {
+	area := r
+	if dx < 0 {
+		area.X += dx
+	}
+	if dy < 0 {
+		area.Y += dy
+	}
+	area.W += abs(dx)
+	area.H += abs(dy)
+	solids := w.query(area, func(e *Entity) bool { return e.Collider.Solid })
+	return append(solids, ground)
+}
+
+func (w *World) hitKillbox() bool {
+	deadly := w.query(w.playerRect(), func(e *Entity) bool { return e.Collider.Deadly })
+	return len(deadly) > 0
+}
+
+// hazardTouched returns the first hazard the player touches, or nil.
+func (w *World) hazardTouched() *Entity {
+	hazards := w.query(w.playerRect(), func(e *Entity) bool { return e.Hazard != nil })
+	if len(hazards) == 0 {
+		return nil
+	}
+	return w.byID[ID(hazards[0].ID)]
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
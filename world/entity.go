@@ -0,0 +1,153 @@
+package world
+
+import "github.com/mariuseis/go-inn/collision"
+
+// ID identifies an entity for the lifetime of a world.
+type ID int
+
+// Entity is anything in the world besides the player. What it is and does
+// is decided only by which components it has; a nil component is absent.
+// Systems pick the entities that have the components they work on, so a
+// new kind of object is just a new combination of components.
+type Entity struct {
+	ID ID
+
+	Position *Position `json:",omitempty"`
+	Velocity *Velocity `json:",omitempty"`
+	Collider *Collider `json:",omitempty"`
+	Sprite   *Sprite   `json:",omitempty"`
+	Health   *Health   `json:",omitempty"`
+	Damage   *Damage   `json:",omitempty"`
+	Hazard   *Hazard   `json:",omitempty"`
+	Pickup   *Pickup   `json:",omitempty"`
+	AI       *AI       `json:",omitempty"`
+	Lifetime *Lifetime `json:",omitempty"`
+
+	// Removed entities are dropped at the end of the current step.
+	Removed bool `json:",omitempty"`
+}
+
+type Position struct {
+	X int
+	Y int
+}
+
+// Velocity is added to the Position every tick.
+type Velocity struct {
+	X int
+	Y int
+}
+
+// Collider is the entity's box, anchored at its Position.
+type Collider struct {
+	Width  int
+	Height int
+	// Solid colliders can be stood on, Deadly ones end the run on touch.
+	Solid  bool `json:",omitempty"`
+	Deadly bool `json:",omitempty"`
+}
+
+// Sprite says how the entity is drawn. Image names a picture known to the
+// renderer; Tiles, when non-zero, repeats the tileset tile Tile that many
+// times to the right instead.
+type Sprite struct {
+	Image string
+	Tile  int  `json:",omitempty"`
+	Tiles int  `json:",omitempty"`
+	FlipX bool `json:",omitempty"`
+}
+
+type Health struct {
+	Current int
+	Max     int
+}
+
+// Damage is dealt to the first entity with Health the collider touches,
+// after which the entity is removed.
+type Damage struct {
+	Amount int
+}
+
+// Hazard hurts the player on contact.
+type Hazard struct {
+	Damage int
+}
+
+// Pickup is collected by the player on touch.
+type Pickup struct {
+	Coins int
+}
+
+// AI moves the entity towards the player at Speed pixels per tick.
+type AI struct {
+	Speed int
+}
+
+// Lifetime counts down the ticks until the entity is removed.
+type Lifetime struct {
+	Ticks int
+}
+
+// GridCellSize is the cell size of the spatial hash used for collision
+// queries.
+const GridCellSize = 4 * TileSize
+
+// Spawn adds e to the world and gives it a fresh ID.
+func (w *World) Spawn(e *Entity) *Entity {
+	w.NextID++
+	e.ID = w.NextID
+	w.Entities = append(w.Entities, e)
+	w.track(e)
+	return e
+}
+
+// Entity returns the entity with the given ID, or nil.
+func (w *World) Entity(id ID) *Entity {
+	w.ensureIndex()
+	return w.byID[id]
+}
+
+// ensureIndex builds the lookup structures if the world does not have them
+// yet.
+func (w *World) ensureIndex() {
+	if w.byID != nil {
+		return
+	}
+	w.byID = map[ID]*Entity{}
+	w.grid = collision.NewGrid(GridCellSize)
+	for _, e := range w.Entities {
+		w.track(e)
+	}
+}
+
+func (w *World) track(e *Entity) {
+	w.ensureIndex()
+	w.byID[e.ID] = e
+	if e.Collider != nil && e.Position != nil {
+		w.grid.Insert(collision.Body{ID: int(e.ID), Rect: entityRect(e)})
+	}
+}
+
+// moved updates the spatial hash after e's position changed.
+func (w *World) moved(e *Entity) {
+	if e.Collider != nil {
+		w.grid.Update(collision.Body{ID: int(e.ID), Rect: entityRect(e)})
+	}
+}
+
+// sweep drops removed entities, keeping the others in spawn order.
+func (w *World) sweep() {
+	kept := w.Entities[:0]
+	for _, e := range w.Entities {
+		if !e.Removed {
+			kept = append(kept, e)
+			continue
+		}
+		w.grid.Remove(int(e.ID))
+		delete(w.byID, e.ID)
+	}
+	for i := len(kept); i < len(w.Entities); i++ {
+		w.Entities[i] = nil
+	}
+	w.Entities = kept
+}
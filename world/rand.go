@@ -0,0 +1,49 @@
+package world
+
+// Rand is a splitmix64 generator. Its whole state is a single exported word,
+// so the same seed always yields the same sequence on every platform.
+type Rand struct {
+	State uint64
+}
+
+func (r *Rand) Uint64() uint64 {
+	r.State += 0x9e3779b97f4a7c15
+	z := r.State
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Intn returns a number in [0, n). It panics if n <= 0.
+func (r *Rand) Intn(n int) int {
+	if n <= 0 {
+		panic("world: invalid argument to Intn")
+	}
+	return int(r.Uint64() % uint64(n))
+}
+
+// Float64 returns a number in [0, 1).
+func (r *Rand) Float64() float64 {
+	return float64(r.Uint64()>>11) / (1 << 53)
+}
+
+// Streams splits one seed into independent generators per subsystem, so
+// that e.g. a change in how loot is rolled does not shift where enemies
+// spawn.
+type Streams struct {
+	Spawn Rand
+	Loot  Rand
+	// Effects is for cosmetic randomness, which must not change what
+	// the other streams roll.
+	Effects Rand
+}
+
+func NewStreams(seed int64) Streams {
+	root := Rand{State: uint64(seed)}
+	return Streams{
+		Spawn: Rand{State: root.Uint64()},
+		Loot:  Rand{State: root.Uint64()},
+		// derived last, so that adding it left the others as they were
+		Effects: Rand{State: root.Uint64()},
+	}
+}
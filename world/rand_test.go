@@ -0,0 +1,72 @@
+package world
+
+import "testing"
+
+func draw(r *Rand, n int) []uint64 {
+	var v []uint64
+	for i := 0; i < n; i++ {
+		v = append(v, r.Uint64())
+	}
+	return v
+}
+
+func equal(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestStreamsStable(t *testing.T) {
+	a, b := NewStreams(42), NewStreams(42)
+	for _, c := range []struct {
+		name string
+		a, b *Rand
+	}{
+		{"spawn", &a.Spawn, &b.Spawn},
+		{"loot", &a.Loot, &b.Loot},
+		{"effects", &a.Effects, &b.Effects},
+	} {
+		if !equal(draw(c.a, 100), draw(c.b, 100)) {
+			t.Errorf("%s: the same seed gave different sequences", c.name)
+		}
+	}
+
+	// Spawn and Loot are the first and second values of the root stream,
+	// as they were before Effects was added.
+	root := Rand{State: 42}
+	s := NewStreams(42)
+	if s.Spawn.State != root.Uint64() || s.Loot.State != root.Uint64() {
+		t.Error("spawn and loot are not derived first from the seed")
+	}
+}
+
+func TestStreamsIndependent(t *testing.T) {
+	fresh := NewStreams(7)
+	want := map[string][]uint64{
+		"spawn":   draw(&fresh.Spawn, 50),
+		"loot":    draw(&fresh.Loot, 50),
+		"effects": draw(&fresh.Effects, 50),
+	}
+
+	// drawing a lot from one stream does not shift the others
+	for _, heavy := range []string{"spawn", "loot", "effects"} {
+		s := NewStreams(7)
+		streams := map[string]*Rand{"spawn": &s.Spawn, "loot": &s.Loot, "effects": &s.Effects}
+		draw(streams[heavy], 1000)
+		for name, r := range streams {
+			if name != heavy && !equal(draw(r, 50), want[name]) {
+				t.Errorf("drawing from %s changed %s", heavy, name)
+			}
+		}
+	}
+
+	if equal(want["spawn"], want["loot"]) || equal(want["spawn"], want["effects"]) || equal(want["loot"], want["effects"]) {
+		t.Error("two streams give the same sequence")
+	}
+}
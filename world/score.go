@@ -0,0 +1,39 @@
+package world
+
+const (
+	// CoinScore is awarded per coin picked up.
+	CoinScore = 10
+	// TimeBonusPerSecond is awarded for every second a level is finished
+	// under its par time.
+	TimeBonusPerSecond = 20
+	// DefaultParTime is the par time in seconds of levels without one.
+	DefaultParTime = 60
+)
+
+// Score is kept per component, so each can be shown on its own.
+type Score struct {
+	Coins int
+	Kills int
+	Time  int
+}
+
+func (s Score) Total() int {
+	return s.Coins + s.Kills + s.Time
+}
+
+func (s Score) Add(o Score) Score {
+	return Score{
+		Coins: s.Coins + o.Coins,
+		Kills: s.Kills + o.Kills,
+		Time:  s.Time + o.Time,
+	}
+}
+
+// timeBonus returns the bonus for finishing after ticks.
+func (w *World) timeBonus() int {
+	left := w.ParTicks - w.Ticks
+	if left <= 0 {
+		return 0
+	}
+	return left / TicksPerSecond * TimeBonusPerSecond
+}
@@ -0,0 +1,55 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/mariuseis/go-inn/level"
+)
+
+func TestTimeBonus(t *testing.T) {
+	const par = 10 * TicksPerSecond
+	for _, c := range []struct {
+		ticks int
+		want  int
+	}{
+		{0, 10 * TimeBonusPerSecond},
+		{TicksPerSecond - 1, 9 * TimeBonusPerSecond},
+		{TicksPerSecond, 9 * TimeBonusPerSecond},
+		{par - TicksPerSecond, TimeBonusPerSecond},
+		// whole seconds only
+		{par - 1, 0},
+		{par, 0},
+		{par + 100, 0},
+	} {
+		w := &World{ParTicks: par, Ticks: c.ticks}
+		if got := w.timeBonus(); got != c.want {
+			t.Errorf("%d ticks of %d: bonus %d, want %d", c.ticks, par, got, c.want)
+		}
+	}
+}
+
+func TestGoalTimeBonus(t *testing.T) {
+	for _, c := range []struct {
+		parTime int
+		want    int
+	}{
+		{0, DefaultParTime * TimeBonusPerSecond},
+		{30, 30 * TimeBonusPerSecond},
+	} {
+		l := &level.Level{
+			Version:     level.Version,
+			Name:        "test",
+			PlayerStart: level.Point{X: 100, Y: groundY},
+			Goal:        level.Rect{X: 100, Y: 0, Width: 100, Height: ScreenHeight},
+			ParTime:     c.parTime,
+		}
+		w := New(l, 1, Upgrades{})
+
+		if n := count(step(w, 2, Input{}), EventGoalReached); n != 1 {
+			t.Errorf("par time %d: goal reached %d times, want once", c.parTime, n)
+		}
+		if w.Score.Time != c.want || !w.Finished {
+			t.Errorf("par time %d: time bonus %d, finished %v, want %d, true", c.parTime, w.Score.Time, w.Finished, c.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package world
+
+// Systems run once per Step in the order they appear here. Each one goes
+// through the entities in spawn order, which keeps the simulation
+// deterministic.
+
+// aiSystem points every AI entity towards the player.
+func (w *World) aiSystem() {
+	for _, e := range w.Entities {
+		if e.AI == nil || e.Position == nil || e.Velocity == nil {
+			continue
+		}
+		left := e.Position.X > w.Player.X16
+		if left {
+			e.Velocity.X = -e.AI.Speed
+		} else {
+			e.Velocity.X = e.AI.Speed
+		}
+		if e.Sprite != nil {
+			e.Sprite.FlipX = left
+		}
+	}
+}
+
+func (w *World) movementSystem() {
+	for _, e := range w.Entities {
+		if e.Position == nil || e.Velocity == nil {
+			continue
+		}
+		e.Position.X += e.Velocity.X
+		e.Position.Y += e.Velocity.Y
+		w.moved(e)
+	}
+}
+
+// damageSystem lets entities with Damage hurt the entities with Health
+// they touch, and removes the ones whose health runs out.
+func (w *World) damageSystem() []Event {
+	var events []Event
+	for _, e := range w.Entities {
+		if e.Damage == nil || e.Collider == nil || e.Removed {
+			continue
+		}
+		targets := w.query(entityRect(e), func(t *Entity) bool {
+			return t != e && t.Health != nil
+		})
+		if len(targets) == 0 {
+			continue
+		}
+
+		target := w.byID[ID(targets[0].ID)]
+		target.Health.Current -= e.Damage.Amount
+		e.Removed = true
+		events = append(events, EventEnemyHit)
+		if target.Health.Current <= 0 {
+			target.Removed = true
+			w.Kills++
+			w.Score.Kills += KillScore
+			w.dropCoin(target)
+			events = append(events, EventEnemyKilled)
+		}
+	}
+	return events
+}
+
+// playerDamageSystem hurts the player on hazards and kills it in kill
+// boxes or when its health runs out.
+func (w *World) playerDamageSystem() []Event {
+	p := &w.Player
+	if w.hitKillbox() {
+		p.Health = 0
+		return []Event{EventDeath}
+	}
+
+	if p.Invincible > 0 {
+		p.Invincible--
+		return nil
+	}
+	h := w.hazardTouched()
+	if h == nil {
+		return nil
+	}
+
+	p.Health -= h.Hazard.Damage
+	if p.Health <= 0 {
+		p.Health = 0
+		return []Event{EventPlayerHurt, EventDeath}
+	}
+
+	p.Invincible = InvincibilityTicks
+	p.Knockback = KnockbackTicks
+	// away from the hazard
+	if h.Position.X+h.Collider.Width/2 > p.X16+PlayerWidth/2 {
+		p.VX16 = -KnockbackVelocityX
+	} else {
+		p.VX16 = KnockbackVelocityX
+	}
+	p.VY16 = KnockbackVelocityY
+	return []Event{EventPlayerHurt}
+}
+
+// dropCoin leaves a coin where the killed entity stood.
+func (w *World) dropCoin(e *Entity) {
+	value := MinKillCoins + w.RNG.Loot.Intn(MaxKillCoins-MinKillCoins+1)
+	x := e.Position.X + (e.Collider.Width-CoinSize)/2
+	y := e.Position.Y + e.Collider.Height - CoinSize
+	w.spawnCoin(x, y, value)
+}
+
+// pickupSystem collects the pickups the player touches.
+func (w *World) pickupSystem() []Event {
+	var events []Event
+	for _, b := range w.query(w.playerRect(), func(e *Entity) bool { return e.Pickup != nil }) {
+		e := w.byID[ID(b.ID)]
+		w.Coins += e.Pickup.Coins
+		w.Score.Coins += e.Pickup.Coins * CoinScore
+		e.Removed = true
+		events = append(events, EventCoinPickup)
+	}
+	return events
+}
+
+func (w *World) lifetimeSystem() {
+	for _, e := range w.Entities {
+		if e.Lifetime == nil {
+			continue
+		}
+		e.Lifetime.Ticks--
+		if e.Lifetime.Ticks < 1 {
+			e.Removed = true
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package world
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mariuseis/go-inn/level"
+)
+
+// find returns the entities that match.
+func find(w *World, match func(*Entity) bool) []*Entity {
+	var found []*Entity
+	for _, e := range w.Entities {
+		if match(e) {
+			found = append(found, e)
+		}
+	}
+	return found
+}
+
+func isEnemy(e *Entity) bool      { return e.Health != nil }
+func isProjectile(e *Entity) bool { return e.Damage != nil }
+func isCoin(e *Entity) bool       { return e.Pickup != nil }
+
+// shootEnemy fires at an enemy standing to the right of the player until it
+// dies and returns the events of every tick.
+func shootEnemy(t *testing.T, seed int64) (*World, []Event) {
+	w := newTestWorld(level.Point{X: 100, Y: groundY})
+	w.RNG = NewStreams(seed)
+	w.spawnEnemy(200, enemyGroundY)
+	step(w, 1, Input{})
+
+	var events []Event
+	for shot := 0; shot < EnemyHealth; shot++ {
+		events = append(events, w.Step(Input{Fire: true})...)
+		for i := 0; i < 30 && len(find(w, isProjectile)) > 0; i++ {
+			events = append(events, w.Step(Input{})...)
+		}
+		if n := len(find(w, isProjectile)); n > 0 {
+			t.Fatalf("seed %d: shot %d still flying", seed, shot)
+		}
+	}
+	return w, events
+}
+
+func TestProjectileHitsEnemy(t *testing.T) {
+	w := newTestWorld(level.Point{X: 100, Y: groundY})
+	w.spawnEnemy(200, enemyGroundY)
+	step(w, 1, Input{})
+
+	events := step(w, 1, Input{Fire: true})
+	if len(find(w, isProjectile)) != 1 {
+		t.Fatal("no projectile fired")
+	}
+	events = append(events, step(w, 20, Input{})...)
+
+	if n := count(events, EventEnemyHit); n != 1 {
+		t.Errorf("%d hits, want 1", n)
+	}
+	if n := len(find(w, isProjectile)); n != 0 {
+		t.Errorf("%d projectiles left after the hit, want 0", n)
+	}
+	enemies := find(w, isEnemy)
+	if len(enemies) != 1 || enemies[0].Health.Current != EnemyHealth-ProjectileDamage {
+		t.Fatalf("enemies %v, want one with %d health", enemies, EnemyHealth-ProjectileDamage)
+	}
+	if count(events, EventEnemyKilled) != 0 || w.Kills != 0 {
+		t.Error("enemy killed by the first hit")
+	}
+}
+
+func TestProjectileKillsEnemy(t *testing.T) {
+	values := map[int]bool{}
+	for _, seed := range []int64{1, 2, 3, 4, 5, 6, 7, 8} {
+		w, events := shootEnemy(t, seed)
+
+		if n := count(events, EventEnemyHit); n != EnemyHealth {
+			t.Errorf("seed %d: %d hits, want %d", seed, n, EnemyHealth)
+		}
+		if n := count(events, EventEnemyKilled); n != 1 {
+			t.Errorf("seed %d: %d kills, want 1", seed, n)
+		}
+		if n := len(find(w, isEnemy)); n != 0 {
+			t.Errorf("seed %d: %d enemies left, want 0", seed, n)
+		}
+		if w.Kills != 1 || w.Score.Kills != KillScore {
+			t.Errorf("seed %d: Kills = %d, kill score %d, want 1, %d", seed, w.Kills, w.Score.Kills, KillScore)
+		}
+
+		coins := find(w, isCoin)
+		if len(coins) != 1 {
+			t.Fatalf("seed %d: %d coins dropped, want 1", seed, len(coins))
+		}
+		c := coins[0]
+		if v := c.Pickup.Coins; v < MinKillCoins || v > MaxKillCoins {
+			t.Errorf("seed %d: coin worth %d, want %d to %d", seed, v, MinKillCoins, MaxKillCoins)
+		}
+		values[c.Pickup.Coins] = true
+		if bottom := c.Position.Y + CoinSize; bottom != enemyGroundY+PlayerHeight {
+			t.Errorf("seed %d: coin bottom at %d, want on the ground at %d", seed, bottom, enemyGroundY+PlayerHeight)
+		}
+	}
+	if len(values) < 2 {
+		t.Errorf("every seed dropped a coin worth %v", values)
+	}
+}
+
+// spawnHazard puts a hazard of the given size at x, y that stays where it
+// is.
+func spawnHazard(w *World, x, y, width, height int) {
+	w.Spawn(&Entity{
+		Position: &Position{X: x, Y: y},
+		Collider: &Collider{Width: width, Height: height},
+		Hazard:   &Hazard{Damage: EnemyContactDamage},
+	})
+}
+
+func TestContactDamage(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		enemyX  int
+		health  int
+		events  []Event
+		wantVX  int
+		wantHit bool
+	}{
+		{"enemy on the right", 150, PlayerHealth, []Event{EventPlayerHurt}, -KnockbackVelocityX, true},
+		{"enemy on the left", 50, PlayerHealth, []Event{EventPlayerHurt}, KnockbackVelocityX, true},
+		{"last health", 150, 1, []Event{EventPlayerHurt, EventDeath}, 0, false},
+	} {
+		w := newTestWorld(level.Point{X: 100, Y: groundY})
+		step(w, 1, Input{})
+		w.Player.Health = c.health
+		w.spawnEnemy(c.enemyX, enemyGroundY)
+
+		var events []Event
+		for _, e := range w.Step(Input{}) {
+			if e != EventLand {
+				events = append(events, e)
+			}
+		}
+		if !reflect.DeepEqual(events, c.events) {
+			t.Errorf("%s: events %v, want %v", c.name, events, c.events)
+		}
+		p := w.Player
+		if p.Health != c.health-EnemyContactDamage {
+			t.Errorf("%s: health %d, want %d", c.name, p.Health, c.health-EnemyContactDamage)
+		}
+		if !c.wantHit {
+			continue
+		}
+		if p.VX16 != c.wantVX || p.VY16 != KnockbackVelocityY {
+			t.Errorf("%s: velocity %d, %d, want %d, %d", c.name, p.VX16, p.VY16, c.wantVX, KnockbackVelocityY)
+		}
+		if p.Knockback != KnockbackTicks || p.Invincible != InvincibilityTicks {
+			t.Errorf("%s: knockback %d, invincible %d, want %d, %d",
+				c.name, p.Knockback, p.Invincible, KnockbackTicks, InvincibilityTicks)
+		}
+	}
+}
+
+func TestKnockback(t *testing.T) {
+	w := newTestWorld(level.Point{X: 1000, Y: groundY})
+	step(w, 1, Input{})
+	w.spawnEnemy(1050, enemyGroundY)
+	w.Step(Input{})
+
+	// the input is ignored while thrown back
+	for i := 0; i < KnockbackTicks; i++ {
+		w.Step(Input{Right: true})
+		if w.Player.VX16 != -KnockbackVelocityX {
+			t.Fatalf("tick %d of the knockback: VX = %d, want %d", i, w.Player.VX16, -KnockbackVelocityX)
+		}
+	}
+	w.Step(Input{Right: true})
+	if want := -KnockbackVelocityX + MoveAcceleration; w.Player.VX16 != want {
+		t.Errorf("after the knockback: VX = %d, want %d", w.Player.VX16, want)
+	}
+}
+
+func TestInvincibility(t *testing.T) {
+	w := newTestWorld(level.Point{X: 1000, Y: groundY})
+	step(w, 1, Input{})
+	w.Player.Health = 10
+	// wide enough to stay in through the knockback
+	spawnHazard(w, 0, 0, 3000, ScreenHeight)
+
+	if n := count(w.Step(Input{}), EventPlayerHurt); n != 1 {
+		t.Fatalf("%d hurt events on contact, want 1", n)
+	}
+	if n := count(step(w, InvincibilityTicks, Input{}), EventPlayerHurt); n != 0 {
+		t.Errorf("hurt %d times while invincible", n)
+	}
+	if n := count(w.Step(Input{}), EventPlayerHurt); n != 1 {
+		t.Errorf("%d hurt events after the invincibility ran out, want 1", n)
+	}
+	if w.Player.Health != 8 {
+		t.Errorf("health %d, want 8", w.Player.Health)
+	}
+}
+
+func TestKillBoxDeath(t *testing.T) {
+	l := &level.Level{
+		Version:     level.Version,
+		Name:        "test",
+		PlayerStart: level.Point{X: 40, Y: 100},
+		KillBoxes:   []level.Strip{{X: 0, Y: 300, Tiles: 5}},
+		Goal:        level.Rect{X: 10000, Y: 0, Width: 100, Height: 100},
+	}
+	w := New(l, 1, Upgrades{ExtraHealth: 5})
+
+	// the game stops at the first death, so step only until then
+	var events []Event
+	for i := 0; i < 60 && count(events, EventDeath) == 0; i++ {
+		events = append(events, w.Step(Input{})...)
+	}
+	if count(events, EventDeath) != 1 {
+		t.Fatal("no death in the kill box")
+	}
+	if count(events, EventPlayerHurt) != 0 {
+		t.Error("falling into a kill box hurt the player before killing it")
+	}
+	if w.Player.Health != 0 {
+		t.Errorf("health %d at full health in a kill box, want 0", w.Player.Health)
+	}
+}
+
+func TestCoinPickup(t *testing.T) {
+	l := &level.Level{
+		Version:     level.Version,
+		Name:        "test",
+		PlayerStart: level.Point{X: 100, Y: groundY},
+		// two under the player, one out of reach
+		Coins: []level.Point{{X: 110, Y: groundY + 10}, {X: 140, Y: groundY + 40}, {X: 400, Y: 100}},
+		Goal:  level.Rect{X: 10000, Y: 0, Width: 100, Height: 100},
+	}
+	w := New(l, 1, Upgrades{})
+	events := step(w, 1, Input{})
+
+	if n := count(events, EventCoinPickup); n != 2 {
+		t.Errorf("%d pickups, want 2", n)
+	}
+	if w.Coins != 2 || w.Score.Coins != 2*CoinScore {
+		t.Errorf("Coins = %d, coin score %d, want 2, %d", w.Coins, w.Score.Coins, 2*CoinScore)
+	}
+	if n := len(find(w, isCoin)); n != 1 {
+		t.Errorf("%d coins left, want 1", n)
+	}
+	if n := count(step(w, 10, Input{}), EventCoinPickup); n != 0 {
+		t.Errorf("the same coins picked up %d more times", n)
+	}
+}
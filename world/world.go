@@ -0,0 +1,348 @@
+// Package world holds the game state and rules. It has no dependency on
+// ebiten, so the simulation can be stepped and inspected without a display.
+package world
+
+import (
+	"github.com/mariuseis/go-inn/collision"
+	"github.com/mariuseis/go-inn/level"
+)
+
+const (
+	ScreenWidth    = 640
+	ScreenHeight   = 480
+	TileSize       = level.TileSize
+	TicksPerSecond = 60
+
+	ProjectileSpeed    = 5
+	ProjectileLifespan = 200
+	ProjectileDamage   = 1
+	ProjectileSize     = 32
+
+	EnemyHealth = 2
+	// KillScore is awarded for every enemy killed. A killed enemy drops
+	// a coin worth between MinKillCoins and MaxKillCoins.
+	KillScore    = 100
+	MinKillCoins = 1
+	MaxKillCoins = 3
+
+	CoinSize = 16
+
+	MaxMoveVelocity     = 3
+	MoveAcceleration    = 1
+	GravityAcceleration = 1
+	MaxGravityVelocity  = 8
+	JumpVelocity        = 8
+	MaxJumps            = 2
+
+	PlayerHealth = 3
+	// InvincibilityTicks is how long the player can't be hurt again after
+	// taking damage, KnockbackTicks how long it is thrown back without
+	// control.
+	InvincibilityTicks = 90
+	KnockbackTicks     = 12
+	KnockbackVelocityX = 6
+	KnockbackVelocityY = -10
+
+	EnemyContactDamage = 1
+
+	// PlayerWidth and PlayerHeight match the gopher sprite and are the
+	// player's size for every collision check.
+	PlayerWidth  = 60
+	PlayerHeight = 75
+)
+
+// Input is everything the simulation reads from the player in one tick.
+// Left and Right are held states, Jump and Fire are edges (just pressed).
+type Input struct {
+	Left  bool
+	Right bool
+	Jump  bool
+	Fire  bool
+}
+
+// Event is something that happened during a Step that the caller may want
+// to react to, e.g. by playing a sound or changing the mode.
+type Event int
+
+const (
+	EventJump Event = iota
+	EventDeath
+	EventEnemyHit
+	EventEnemyKilled
+	EventPlayerHurt
+	// EventGoalReached fires once, when the player first enters the inn.
+	EventGoalReached
+	EventCoinPickup
+	EventShoot
+	// EventLand fires when the player comes down on the ground or a
+	// platform.
+	EventLand
+)
+
+type Player struct {
+	// The gopher's position
+	X16  int
+	Y16  int
+	VY16 int
+	VX16 int
+
+	MovingLeft bool
+	JumpCount  int
+	// OnGround is set while the player stands on the ground or a platform.
+	OnGround bool
+
+	Health    int
+	MaxHealth int
+	// Invincible and Knockback count down the ticks left of the
+	// corresponding state.
+	Invincible int
+	Knockback  int
+}
+
+// Upgrades are bought at the inn and improve the player in the levels
+// that follow.
+type Upgrades struct {
+	ExtraJumps int
+	// ProjectileSpeed is added to the speed of every shot.
+	ProjectileSpeed int
+	ExtraHealth     int
+}
+
+type World struct {
+	// Seed is the value the RNG streams were derived from. The same seed
+	// and the same sequence of inputs always produce the same world.
+	Seed int64
+	RNG  Streams
+
+	Player   Player
+	Upgrades Upgrades
+
+	// Camera
+	CameraX int
+	CameraY int
+
+	// Entities are everything besides the player: platforms, kill boxes,
+	// enemies and projectiles, in the order they were spawned.
+	Entities []*Entity
+	NextID   ID
+
+	// grid indexes the colliders of Entities for collision queries and
+	// byID looks entities up by ID. Both are kept in sync by Spawn, the
+	// movement system and sweep.
+	grid *collision.Grid
+	byID map[ID]*Entity
+
+	// Goal is the area covered by the inn.
+	Goal collision.Rect
+
+	Kills int
+	Score Score
+	// Coins are the coins picked up in this level.
+	Coins int
+
+	// Ticks counts the steps taken so far. Finishing in under ParTicks
+	// earns a time bonus.
+	Ticks    int
+	ParTicks int
+	// Finished is set once the player has reached the goal.
+	Finished bool
+}
+
+// New builds a world from a level description. The level is expected to
+// have passed level.Validate.
+func New(l *level.Level, seed int64, upgrades Upgrades) *World {
+	health := PlayerHealth + upgrades.ExtraHealth
+	w := &World{
+		Seed:     seed,
+		RNG:      NewStreams(seed),
+		Upgrades: upgrades,
+		Player:   Player{X16: l.PlayerStart.X, Y16: l.PlayerStart.Y, Health: health, MaxHealth: health},
+		CameraX:  l.PlayerStart.X - 240,
+		CameraY:  0,
+		Goal:     collision.Rect{X: l.Goal.X, Y: l.Goal.Y, W: l.Goal.Width, H: l.Goal.Height},
+		ParTicks: DefaultParTime * TicksPerSecond,
+	}
+	if l.ParTime > 0 {
+		w.ParTicks = l.ParTime * TicksPerSecond
+	}
+
+	for _, s := range l.Platforms {
+		w.spawnTiles(s, "platform", Collider{Solid: true})
+	}
+	for _, s := range l.KillBoxes {
+		w.spawnTiles(s, "killbox", Collider{Deadly: true})
+	}
+
+	for _, p := range l.Coins {
+		w.spawnCoin(p.X, p.Y, 1)
+	}
+
+	for _, p := range l.EnemySpawns {
+		w.spawnEnemy(p.X, p.Y)
+	}
+	if r := l.RandomEnemies; r != nil {
+		enemyCount := w.RNG.Spawn.Intn(r.Max + 1)
+		for i := 1; i <= enemyCount; i++ {
+			x := r.MinX + w.RNG.Spawn.Intn(r.MaxX-r.MinX)
+			w.spawnEnemy(x, enemyGroundY)
+		}
+	}
+
+	return w
+}
+
+// enemyGroundY puts an enemy's feet on the ground.
+const enemyGroundY = ScreenHeight - TileSize - PlayerHeight
+
+func (w *World) spawnTiles(s level.Strip, image string, c Collider) {
+	c.Width = s.Tiles * TileSize
+	c.Height = TileSize
+	w.Spawn(&Entity{
+		Position: &Position{X: s.X, Y: s.Y},
+		Collider: &c,
+		Sprite:   &Sprite{Image: image, Tile: s.Tile, Tiles: s.Tiles},
+	})
+}
+
+func (w *World) spawnEnemy(x, y int) {
+	w.Spawn(&Entity{
+		Position: &Position{X: x, Y: y},
+		Velocity: &Velocity{},
+		Collider: &Collider{Width: PlayerWidth, Height: PlayerHeight},
+		Sprite:   &Sprite{Image: "enemy"},
+		Health:   &Health{Current: EnemyHealth, Max: EnemyHealth},
+		Hazard:   &Hazard{Damage: EnemyContactDamage},
+		AI:       &AI{Speed: 1},
+	})
+}
+
+func (w *World) spawnCoin(x, y, value int) {
+	w.Spawn(&Entity{
+		Position: &Position{X: x, Y: y},
+		Collider: &Collider{Width: CoinSize, Height: CoinSize},
+		Sprite:   &Sprite{Image: "coin"},
+		Pickup:   &Pickup{Coins: value},
+	})
+}
+
+func (w *World) spawnProjectile() {
+	p := w.Player
+	vx := ProjectileSpeed + w.Upgrades.ProjectileSpeed
+	if p.MovingLeft {
+		vx = -vx
+	}
+	w.Spawn(&Entity{
+		Position: &Position{X: p.X16, Y: ScreenHeight - 60 - (384 - p.Y16)},
+		Velocity: &Velocity{X: vx},
+		Collider: &Collider{Width: ProjectileSize, Height: ProjectileSize},
+		Sprite:   &Sprite{Image: "bullet"},
+		Damage:   &Damage{Amount: ProjectileDamage},
+		Lifetime: &Lifetime{Ticks: ProjectileLifespan},
+	})
+}
+
+// Step advances the world by one tick.
+func (w *World) Step(in Input) []Event {
+	var events []Event
+
+	// A world restored from a save has no index yet.
+	w.ensureIndex()
+
+	if in.Fire {
+		w.spawnProjectile()
+		events = append(events, EventShoot)
+	}
+
+	jumped, landed := w.handleMovement(in)
+	if jumped {
+		events = append(events, EventJump)
+	}
+	if landed {
+		events = append(events, EventLand)
+	}
+	w.aiSystem()
+	w.movementSystem()
+	events = append(events, w.damageSystem()...)
+	w.lifetimeSystem()
+
+	events = append(events, w.playerDamageSystem()...)
+
+	events = append(events, w.pickupSystem()...)
+
+	// a player killed this tick does not make it to the inn
+	if !w.Finished && w.Player.Health > 0 && w.playerRect().Overlaps(w.Goal) {
+		w.Finished = true
+		w.Score.Time = w.timeBonus()
+		events = append(events, EventGoalReached)
+	}
+
+	w.Ticks++
+
+	w.sweep()
+	return events
+}
+
+// handleMovement applies the input and gravity to the player, moves it
+// against the solids and reports whether it jumped and whether it landed.
+func (w *World) handleMovement(in Input) (jumped, landed bool) {
+	p := &w.Player
+	areBothPressed := in.Left && in.Right
+
+	p.MovingLeft = !areBothPressed && in.Left
+
+	if in.Jump && p.JumpCount < MaxJumps+w.Upgrades.ExtraJumps {
+		p.VY16 = -JumpVelocity * 2
+		p.JumpCount++
+		jumped = true
+	}
+
+	if p.Knockback > 0 {
+		// thrown back, keep the knockback velocity
+		p.Knockback--
+	} else if areBothPressed {
+		p.VX16 = 0
+	} else if in.Left {
+		p.VX16 -= MoveAcceleration
+		if p.VX16 < -MaxMoveVelocity {
+			p.VX16 = -MaxMoveVelocity
+		}
+	} else if in.Right {
+		p.VX16 += MoveAcceleration
+		if p.VX16 > MaxMoveVelocity {
+			p.VX16 = MaxMoveVelocity
+		}
+	} else {
+		p.VX16 = 0
+	}
+
+	// Gravity
+	p.VY16 += GravityAcceleration
+	if p.VY16 > MaxGravityVelocity {
+		p.VY16 = MaxGravityVelocity
+	}
+
+	r := w.playerRect()
+	r, contacts := collision.Move(r, p.VX16, p.VY16, w.solidsAround(r, p.VX16, p.VY16))
+	p.X16, p.Y16 = r.X, r.Y
+
+	wasOnGround := p.OnGround
+	p.OnGround = false
+	for _, c := range contacts {
+		switch {
+		case c.Normal.Y < 0:
+			// landed
+			p.VY16 = 0
+			p.OnGround = true
+			p.JumpCount = 0
+		case c.Normal.Y > 0:
+			// bumped into a ceiling
+			p.VY16 = 0
+		case c.Normal.X != 0:
+			p.VX16 = 0
+		}
+	}
+
+	w.CameraX = p.X16 - 240
+
+	return jumped, p.OnGround && !wasOnGround
+}
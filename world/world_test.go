@@ -0,0 +1,204 @@
+package world
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mariuseis/go-inn/level"
+)
+
+// groundY is the player's Y when standing on the ground.
+const groundY = ScreenHeight - TileSize - PlayerHeight
+
+func newTestWorld(start level.Point, platforms ...level.Strip) *World {
+	l := &level.Level{
+		Version:     level.Version,
+		Name:        "test",
+		PlayerStart: start,
+		Platforms:   platforms,
+		Goal:        level.Rect{X: 10000, Y: 0, Width: 100, Height: 100},
+	}
+	return New(l, 1, Upgrades{})
+}
+
+// step runs the world n ticks with the same input and returns the events.
+func step(w *World, n int, in Input) []Event {
+	var events []Event
+	for i := 0; i < n; i++ {
+		events = append(events, w.Step(in)...)
+	}
+	return events
+}
+
+func count(events []Event, e Event) int {
+	n := 0
+	for _, got := range events {
+		if got == e {
+			n++
+		}
+	}
+	return n
+}
+
+func TestLandOnPlatform(t *testing.T) {
+	w := newTestWorld(level.Point{X: 40, Y: 100}, level.Strip{X: 0, Y: 300, Tiles: 5})
+	events := step(w, 60, Input{})
+
+	p := w.Player
+	if p.Y16 != 300-PlayerHeight {
+		t.Errorf("Y = %d, want %d", p.Y16, 300-PlayerHeight)
+	}
+	if !p.OnGround || p.VY16 != 0 || p.JumpCount != 0 {
+		t.Errorf("OnGround = %v, VY = %d, JumpCount = %d, want true, 0, 0", p.OnGround, p.VY16, p.JumpCount)
+	}
+	if n := count(events, EventLand); n != 1 {
+		t.Errorf("%d land events, want 1", n)
+	}
+}
+
+func TestLandOnGround(t *testing.T) {
+	w := newTestWorld(level.Point{X: 40, Y: 200})
+	step(w, 60, Input{})
+
+	if w.Player.Y16 != groundY || !w.Player.OnGround {
+		t.Errorf("Y = %d, OnGround = %v, want %d, true", w.Player.Y16, w.Player.OnGround, groundY)
+	}
+}
+
+func TestCeilingBump(t *testing.T) {
+	const ceiling = 250
+	w := newTestWorld(level.Point{X: 40, Y: groundY}, level.Strip{X: 0, Y: ceiling, Tiles: 5})
+	step(w, 1, Input{})
+
+	w.Step(Input{Jump: true})
+	top := w.Player.Y16
+	bumped := false
+	for i := 0; i < 60; i++ {
+		w.Step(Input{})
+		if w.Player.Y16 < top {
+			top = w.Player.Y16
+		}
+		if w.Player.Y16 == ceiling+TileSize && w.Player.VY16 == 0 {
+			bumped = true
+		}
+	}
+	if top != ceiling+TileSize {
+		t.Errorf("highest Y = %d, want %d, just under the platform", top, ceiling+TileSize)
+	}
+	if !bumped {
+		t.Error("vertical velocity was not reset at the ceiling")
+	}
+	if w.Player.Y16 != groundY {
+		t.Errorf("Y = %d after falling back, want %d", w.Player.Y16, groundY)
+	}
+}
+
+func TestWallStop(t *testing.T) {
+	const wallX = 200
+	w := newTestWorld(level.Point{X: 40, Y: groundY}, level.Strip{X: wallX, Y: ScreenHeight - 2*TileSize, Tiles: 2})
+	step(w, 120, Input{Right: true})
+
+	if w.Player.X16 != wallX-PlayerWidth {
+		t.Errorf("X = %d, want %d", w.Player.X16, wallX-PlayerWidth)
+	}
+	if w.Player.VX16 != 0 {
+		t.Errorf("VX = %d, want 0", w.Player.VX16)
+	}
+}
+
+func TestJumpLimit(t *testing.T) {
+	for _, extra := range []int{0, 1} {
+		w := newTestWorld(level.Point{X: 40, Y: groundY})
+		w.Upgrades.ExtraJumps = extra
+		step(w, 1, Input{})
+
+		events := step(w, 5, Input{Jump: true})
+		if n, want := count(events, EventJump), MaxJumps+extra; n != want {
+			t.Errorf("%d extra jumps: %d jumps in the air, want %d", extra, n, want)
+		}
+
+		// landing gives the jumps back
+		step(w, 120, Input{})
+		if n := count(step(w, 1, Input{Jump: true}), EventJump); n != 1 {
+			t.Errorf("%d extra jumps: no jump after landing", extra)
+		}
+	}
+}
+
+// run plays a built-in level with a fixed pattern of inputs.
+func run(t *testing.T, seed int64) ([]byte, []Event) {
+	l, err := level.Builtin("meadow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := New(l, seed, Upgrades{})
+	r := Rand{State: 99}
+	var events []Event
+	for i := 0; i < 3000; i++ {
+		in := Input{Left: r.Intn(4) == 0, Right: r.Intn(2) == 0, Jump: r.Intn(20) == 0, Fire: r.Intn(15) == 0}
+		events = append(events, w.Step(in)...)
+	}
+	b, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b, events
+}
+
+func TestDeterminism(t *testing.T) {
+	a, aEvents := run(t, 42)
+	b, bEvents := run(t, 42)
+	if !reflect.DeepEqual(a, b) || !reflect.DeepEqual(aEvents, bEvents) {
+		t.Error("the same seed and inputs gave different worlds")
+	}
+	if c, _ := run(t, 43); reflect.DeepEqual(a, c) {
+		t.Error("different seeds gave the same world")
+	}
+}
+
+func TestProjectileSpeedUpgrade(t *testing.T) {
+	for _, c := range []struct {
+		in   Input
+		want int
+	}{
+		{Input{Right: true, Fire: true}, ProjectileSpeed + 6},
+		{Input{Left: true, Fire: true}, -ProjectileSpeed - 6},
+	} {
+		w := newTestWorld(level.Point{X: 1000, Y: groundY})
+		w.Upgrades.ProjectileSpeed = 6
+		// face the direction first, shots leave the way the player faced
+		// the tick before
+		w.Step(Input{Left: c.in.Left, Right: c.in.Right})
+		w.Step(c.in)
+
+		var got []int
+		for _, e := range w.Entities {
+			if e.Damage != nil {
+				got = append(got, e.Velocity.X)
+			}
+		}
+		if len(got) != 1 || got[0] != c.want {
+			t.Errorf("%+v: projectile speeds %v, want [%d]", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDeathAtGoal(t *testing.T) {
+	l := &level.Level{
+		Version:     level.Version,
+		Name:        "test",
+		PlayerStart: level.Point{X: 40, Y: 280},
+		KillBoxes:   []level.Strip{{X: 0, Y: 300, Tiles: 5}},
+		Goal:        level.Rect{X: 0, Y: 0, Width: 400, Height: 400},
+	}
+	w := New(l, 1, Upgrades{})
+	events := w.Step(Input{})
+
+	if count(events, EventDeath) != 1 || count(events, EventGoalReached) != 0 {
+		t.Errorf("events %v, want a death and no goal", events)
+	}
+	if w.Finished || w.Score.Time != 0 {
+		t.Errorf("Finished = %v, time bonus %d, want false, 0", w.Finished, w.Score.Time)
+	}
+}